@@ -0,0 +1,657 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"git.neds.sh/matty/entain/accounts/proto/accounts"
+)
+
+const (
+	// defaultPageSize is used when the caller does not specify a page size.
+	defaultPageSize = 50
+	// maxPageSize caps the number of transactions returned in a single page.
+	maxPageSize = 100
+
+	// cashLedger is money paid out less money paid in.
+	cashLedger = "cash"
+	// houseLedger is the operator's winnings.
+	houseLedger = "house"
+)
+
+var (
+	// ErrAccountNotFound is returned when an account does not exist.
+	ErrAccountNotFound = errors.New("account not found")
+
+	// ErrInsufficientFunds is returned when an account's available balance
+	// does not cover a withdrawal or stake.
+	ErrInsufficientFunds = errors.New("insufficient funds")
+
+	// ErrReferenceReused is returned when a reference already made a
+	// transaction that differs from the one requested.
+	ErrReferenceReused = errors.New("reference already used")
+
+	// ErrStakeNotFound is returned when no stake was reserved with a
+	// reference.
+	ErrStakeNotFound = errors.New("stake not found")
+
+	// ErrStakeSettled is returned when paying out a stake already refunded,
+	// or refunding one already paid out.
+	ErrStakeSettled = errors.New("stake already settled")
+
+	// ErrInvalidPageToken is returned when a page token cannot be decoded or
+	// was issued for a different account.
+	ErrInvalidPageToken = errors.New("invalid page_token")
+)
+
+// AccountsRepo provides repository access to accounts and their ledger.
+// Every change to a balance is a transaction of entries summing to zero, and
+// balances are the sums of the entries on their ledgers. A transaction
+// repeated with the reference it was made with returns the original, so
+// callers can safely retry.
+type AccountsRepo interface {
+	// Init will initialise our accounts repository.
+	Init(ctx context.Context) error
+
+	// CreateAccount will open an account, returning it with its assigned ID.
+	CreateAccount(ctx context.Context, name string) (*accounts.Account, error)
+
+	// GetAccount will return a single account by its ID, or
+	// ErrAccountNotFound.
+	GetAccount(ctx context.Context, id int64) (*accounts.Account, error)
+
+	// GetBalance will return the balance of an account, or
+	// ErrAccountNotFound.
+	GetBalance(ctx context.Context, accountID int64) (*accounts.Balance, error)
+
+	// ListTransactions will return a page of an account's transactions,
+	// newest first, along with the token of the next page, if any.
+	ListTransactions(ctx context.Context, in *accounts.ListTransactionsRequest) ([]*accounts.Transaction, string, error)
+
+	// Deposit will credit an account with an amount paid in.
+	Deposit(ctx context.Context, accountID, amount int64, reference string) (*accounts.Transaction, error)
+
+	// Withdraw will debit an account with an amount paid out, or return
+	// ErrInsufficientFunds.
+	Withdraw(ctx context.Context, accountID, amount int64, reference string) (*accounts.Transaction, error)
+
+	// ReserveStake will move a stake from an account's available balance to
+	// its held stakes, or return ErrInsufficientFunds. The balance is checked
+	// and the stake held atomically.
+	ReserveStake(ctx context.Context, accountID, amount int64, reference string) (*accounts.Transaction, error)
+
+	// PayoutStake will move the stake reserved with a reference to the
+	// house, and pay the amount returned on the bet from the house to the
+	// account.
+	PayoutStake(ctx context.Context, reference string, amount int64) (*accounts.Transaction, error)
+
+	// RefundStake will return the stake reserved with a reference to the
+	// account.
+	RefundStake(ctx context.Context, reference string) (*accounts.Transaction, error)
+
+	// GetLimits will return the limits in effect on an account, the changes
+	// waiting out their cooling-off period, and any exclusion.
+	GetLimits(ctx context.Context, accountID int64) (*accounts.Limits, error)
+
+	// SetLimit will record a change to a limit, returning its audit record.
+	// Changes that raise or remove a limit take effect once the cooling-off
+	// period has passed, unless superseded by a later change first, and
+	// others at once.
+	SetLimit(ctx context.Context, accountID int64, limit *accounts.Limit, coolingOff time.Duration) (*accounts.AuditRecord, error)
+
+	// SelfExclude will exclude an account for the given duration from now,
+	// returning the audit record of the exclusion, or ErrExclusionShortened
+	// should the account already be excluded for longer.
+	SelfExclude(ctx context.Context, accountID int64, duration time.Duration) (*accounts.AuditRecord, error)
+
+	// ListAuditRecords will return a page of an account's audit records,
+	// newest first, along with the token of the next page, if any.
+	ListAuditRecords(ctx context.Context, in *accounts.ListAuditRecordsRequest) ([]*accounts.AuditRecord, string, error)
+
+	// GetSession will return an account's current session.
+	GetSession(ctx context.Context, accountID int64) (*accounts.Session, error)
+
+	// AcknowledgeReminder will acknowledge the session reminder of an
+	// account, if one is due, returning its session.
+	AcknowledgeReminder(ctx context.Context, accountID int64) (*accounts.Session, error)
+}
+
+type accountsRepo struct {
+	db   *sql.DB
+	init sync.Once
+
+	// mu serialises transactions, so that a balance or limit checked is not
+	// spent by another transaction before the one checking it is made.
+	mu sync.Mutex
+
+	// now returns the current time, and is replaced by tests.
+	now func() time.Time
+}
+
+// NewAccountsRepo creates a new accounts repository.
+func NewAccountsRepo(db *sql.DB) AccountsRepo {
+	return &accountsRepo{db: db, now: time.Now}
+}
+
+// Init migrates the database schema up to date.
+func (r *accountsRepo) Init(ctx context.Context) error {
+	var err error
+
+	r.init.Do(func() {
+		err = migrate(ctx, r.db)
+	})
+
+	return err
+}
+
+func (r *accountsRepo) CreateAccount(ctx context.Context, name string) (*accounts.Account, error) {
+	now := r.clock()
+
+	result, err := r.db.ExecContext(ctx, getAccountQueries()[accountsCreate], name, now.Format(time.RFC3339))
+	if err != nil {
+		return nil, err
+	}
+
+	account := &accounts.Account{Name: name}
+
+	if account.Id, err = result.LastInsertId(); err != nil {
+		return nil, err
+	}
+
+	if account.CreatedAt, err = ptypes.TimestampProto(now); err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+func (r *accountsRepo) GetAccount(ctx context.Context, id int64) (*accounts.Account, error) {
+	return getAccount(ctx, r.db, id)
+}
+
+func (r *accountsRepo) GetBalance(ctx context.Context, accountID int64) (*accounts.Balance, error) {
+	if _, err := getAccount(ctx, r.db, accountID); err != nil {
+		return nil, err
+	}
+
+	balance := &accounts.Balance{AccountId: accountID}
+
+	if err := r.db.QueryRowContext(ctx, getAccountQueries()[entriesBalance], customerLedger(accountID)).Scan(&balance.Available); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.QueryRowContext(ctx, getAccountQueries()[entriesBalance], heldLedger(accountID)).Scan(&balance.Held); err != nil {
+		return nil, err
+	}
+
+	return balance, nil
+}
+
+func (r *accountsRepo) ListTransactions(ctx context.Context, in *accounts.ListTransactionsRequest) ([]*accounts.Transaction, string, error) {
+	if _, err := getAccount(ctx, r.db, in.AccountId); err != nil {
+		return nil, "", err
+	}
+
+	query := getAccountQueries()[transactionsList] + " WHERE account_id = ?"
+	args := []interface{}{in.AccountId}
+
+	if in.PageToken != "" {
+		after, err := decodePageToken(in.PageToken, transactionsPage, in.AccountId)
+		if err != nil {
+			return nil, "", err
+		}
+
+		query += " AND id < ?"
+		args = append(args, after)
+	}
+
+	// One transaction more than a page is fetched, to tell whether another
+	// page follows.
+	size := pageSize(in.PageSize)
+	query += " ORDER BY id DESC LIMIT ?"
+	args = append(args, size+1)
+
+	transactions, err := queryTransactions(ctx, r.db, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(transactions) <= size {
+		return transactions, "", nil
+	}
+
+	transactions = transactions[:size]
+
+	next, err := encodePageToken(transactionsPage, in.AccountId, transactions[size-1].Id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return transactions, next, nil
+}
+
+func (r *accountsRepo) Deposit(ctx context.Context, accountID, amount int64, reference string) (*accounts.Transaction, error) {
+	return r.transfer(ctx, accounts.Transaction_DEPOSIT, accountID, amount, reference, cashLedger, customerLedger(accountID))
+}
+
+func (r *accountsRepo) Withdraw(ctx context.Context, accountID, amount int64, reference string) (*accounts.Transaction, error) {
+	return r.transfer(ctx, accounts.Transaction_WITHDRAWAL, accountID, amount, reference, customerLedger(accountID), cashLedger)
+}
+
+func (r *accountsRepo) ReserveStake(ctx context.Context, accountID, amount int64, reference string) (*accounts.Transaction, error) {
+	return r.transfer(ctx, accounts.Transaction_STAKE, accountID, amount, reference, customerLedger(accountID), heldLedger(accountID))
+}
+
+func (r *accountsRepo) PayoutStake(ctx context.Context, reference string, amount int64) (*accounts.Transaction, error) {
+	return r.settleStake(ctx, accounts.Transaction_PAYOUT, reference, func(stake *accounts.Transaction) (int64, []*accounts.Entry) {
+		entries := []*accounts.Entry{
+			{Ledger: heldLedger(stake.AccountId), Amount: -stake.Amount},
+			{Ledger: houseLedger, Amount: stake.Amount},
+		}
+
+		if amount > 0 {
+			entries = append(entries,
+				&accounts.Entry{Ledger: houseLedger, Amount: -amount},
+				&accounts.Entry{Ledger: customerLedger(stake.AccountId), Amount: amount},
+			)
+		}
+
+		return amount, entries
+	})
+}
+
+func (r *accountsRepo) RefundStake(ctx context.Context, reference string) (*accounts.Transaction, error) {
+	return r.settleStake(ctx, accounts.Transaction_REFUND, reference, func(stake *accounts.Transaction) (int64, []*accounts.Entry) {
+		return stake.Amount, []*accounts.Entry{
+			{Ledger: heldLedger(stake.AccountId), Amount: -stake.Amount},
+			{Ledger: customerLedger(stake.AccountId), Amount: stake.Amount},
+		}
+	})
+}
+
+// transfer makes a transaction for an account moving an amount from one
+// ledger to another. Money leaving a customer's available balance must be
+// covered by it, and deposits and stakes must be allowed by the account's
+// limits, exclusion and session.
+func (r *accountsRepo) transfer(ctx context.Context, txnType accounts.Transaction_Type, accountID, amount int64, reference, from, to string) (*accounts.Transaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var (
+		made *accounts.Transaction
+		now  = r.clock()
+	)
+
+	if err := inTx(ctx, r.db, func(tx *sql.Tx) error {
+		if _, err := getAccount(ctx, tx, accountID); err != nil {
+			return err
+		}
+
+		existing, err := byReference(ctx, tx, reference, txnType, txnType)
+		if err != nil {
+			return err
+		}
+
+		if existing != nil {
+			if existing.AccountId != accountID || existing.Amount != amount {
+				return fmt.Errorf("%w: %s %q was for %d cents on account %d", ErrReferenceReused, txnType, reference, existing.Amount, existing.AccountId)
+			}
+
+			made = existing
+
+			return nil
+		}
+
+		play := txnType == accounts.Transaction_DEPOSIT || txnType == accounts.Transaction_STAKE
+
+		if play {
+			if err := checkPlay(ctx, tx, txnType, accountID, amount, now); err != nil {
+				return err
+			}
+		}
+
+		if from == customerLedger(accountID) {
+			var available int64
+
+			if err := tx.QueryRowContext(ctx, getAccountQueries()[entriesBalance], from).Scan(&available); err != nil {
+				return err
+			}
+
+			if available < amount {
+				return fmt.Errorf("%w: account %d has %d cents available, %d are needed", ErrInsufficientFunds, accountID, available, amount)
+			}
+		}
+
+		made, err = insertTransaction(ctx, tx, &accounts.Transaction{
+			AccountId: accountID,
+			Type:      txnType,
+			Amount:    amount,
+			Reference: reference,
+			Entries: []*accounts.Entry{
+				{Ledger: from, Amount: -amount},
+				{Ledger: to, Amount: amount},
+			},
+		}, now)
+		if err != nil || !play {
+			return err
+		}
+
+		return touchSession(ctx, tx, accountID, now)
+	}); err != nil {
+		return nil, err
+	}
+
+	return made, nil
+}
+
+// settleStake makes the PAYOUT or REFUND of the stake reserved with a
+// reference, of the amount and entries settle returns for the stake. A stake
+// is settled once: repeating its settlement returns the original, and
+// settling it another way fails.
+func (r *accountsRepo) settleStake(ctx context.Context, txnType accounts.Transaction_Type, reference string, settle func(stake *accounts.Transaction) (int64, []*accounts.Entry)) (*accounts.Transaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var (
+		made *accounts.Transaction
+		now  = r.clock()
+	)
+
+	if err := inTx(ctx, r.db, func(tx *sql.Tx) error {
+		stake, err := byReference(ctx, tx, reference, accounts.Transaction_STAKE, accounts.Transaction_STAKE)
+		if err != nil {
+			return err
+		}
+
+		if stake == nil {
+			return fmt.Errorf("%w: no stake was reserved with reference %q", ErrStakeNotFound, reference)
+		}
+
+		amount, entries := settle(stake)
+
+		existing, err := byReference(ctx, tx, reference, accounts.Transaction_PAYOUT, accounts.Transaction_REFUND)
+		if err != nil {
+			return err
+		}
+
+		if existing != nil {
+			if existing.Type != txnType {
+				return fmt.Errorf("%w: stake %q was settled by a %s", ErrStakeSettled, reference, existing.Type)
+			}
+
+			if existing.Amount != amount {
+				return fmt.Errorf("%w: %s %q was for %d cents", ErrReferenceReused, txnType, reference, existing.Amount)
+			}
+
+			made = existing
+
+			return nil
+		}
+
+		made, err = insertTransaction(ctx, tx, &accounts.Transaction{
+			AccountId: stake.AccountId,
+			Type:      txnType,
+			Amount:    amount,
+			Reference: reference,
+			Entries:   entries,
+		}, now)
+
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return made, nil
+}
+
+// insertTransaction records a transaction and its entries, which must sum to
+// zero, returning it with its assigned ID and the time given.
+func insertTransaction(ctx context.Context, tx *sql.Tx, txn *accounts.Transaction, now time.Time) (*accounts.Transaction, error) {
+	var sum int64
+
+	for _, entry := range txn.Entries {
+		sum += entry.Amount
+	}
+
+	if sum != 0 {
+		return nil, fmt.Errorf("unbalanced %s transaction: entries sum to %d", txn.Type, sum)
+	}
+
+	result, err := tx.ExecContext(ctx, getAccountQueries()[transactionsCreate],
+		txn.AccountId,
+		txn.Type.String(),
+		txn.Amount,
+		txn.Reference,
+		now.Format(time.RFC3339),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if txn.Id, err = result.LastInsertId(); err != nil {
+		return nil, err
+	}
+
+	for _, entry := range txn.Entries {
+		if _, err := tx.ExecContext(ctx, getAccountQueries()[entriesCreate], txn.Id, entry.Ledger, entry.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	if txn.CreatedAt, err = ptypes.TimestampProto(now); err != nil {
+		return nil, err
+	}
+
+	return txn, nil
+}
+
+// clock returns the current time, as it is recorded.
+func (r *accountsRepo) clock() time.Time {
+	return r.now().UTC().Truncate(time.Second)
+}
+
+// customerLedger names the ledger of a customer's available balance.
+func customerLedger(accountID int64) string {
+	return fmt.Sprintf("customer/%d", accountID)
+}
+
+// heldLedger names the ledger of a customer's stakes on open bets.
+func heldLedger(accountID int64) string {
+	return fmt.Sprintf("customer/%d/held", accountID)
+}
+
+// querier is satisfied by both *sql.DB and *sql.Tx.
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// getAccount returns a single account by its ID, or ErrAccountNotFound.
+func getAccount(ctx context.Context, q querier, id int64) (*accounts.Account, error) {
+	var (
+		account   accounts.Account
+		createdAt time.Time
+	)
+
+	if err := q.QueryRowContext(ctx, getAccountQueries()[accountsGet], id).Scan(&account.Id, &account.Name, &createdAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAccountNotFound
+		}
+
+		return nil, err
+	}
+
+	var err error
+	if account.CreatedAt, err = ptypes.TimestampProto(createdAt); err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// byReference returns the transaction of either of the given types made with
+// a reference, or nil if there is none.
+func byReference(ctx context.Context, q querier, reference string, first, second accounts.Transaction_Type) (*accounts.Transaction, error) {
+	transactions, err := queryTransactions(ctx, q, getAccountQueries()[transactionsReference], reference, first.String(), second.String())
+	if err != nil || len(transactions) == 0 {
+		return nil, err
+	}
+
+	return transactions[0], nil
+}
+
+// queryTransactions queries transactions, then loads their entries.
+func queryTransactions(ctx context.Context, q querier, query string, args ...interface{}) ([]*accounts.Transaction, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := scanTransactions(rows)
+	if err != nil || len(transactions) == 0 {
+		return transactions, err
+	}
+
+	byID := make(map[int64]*accounts.Transaction, len(transactions))
+
+	var (
+		placeholders []string
+		ids          []interface{}
+	)
+
+	for _, txn := range transactions {
+		byID[txn.Id] = txn
+		placeholders = append(placeholders, "?")
+		ids = append(ids, txn.Id)
+	}
+
+	entryRows, err := q.QueryContext(ctx,
+		getAccountQueries()[entriesList]+" WHERE transaction_id IN ("+strings.Join(placeholders, ",")+") ORDER BY id",
+		ids...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer entryRows.Close()
+
+	for entryRows.Next() {
+		var (
+			entry accounts.Entry
+			txnID int64
+		)
+
+		if err := entryRows.Scan(&txnID, &entry.Ledger, &entry.Amount); err != nil {
+			return nil, err
+		}
+
+		byID[txnID].Entries = append(byID[txnID].Entries, &entry)
+	}
+
+	if err := entryRows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+func scanTransactions(rows *sql.Rows) ([]*accounts.Transaction, error) {
+	defer rows.Close()
+
+	var transactions []*accounts.Transaction
+
+	for rows.Next() {
+		var (
+			txn       accounts.Transaction
+			txnType   string
+			createdAt time.Time
+		)
+
+		if err := rows.Scan(&txn.Id, &txn.AccountId, &txnType, &txn.Amount, &txn.Reference, &createdAt); err != nil {
+			return nil, err
+		}
+
+		txn.Type = accounts.Transaction_Type(accounts.Transaction_Type_value[txnType])
+
+		ts, err := ptypes.TimestampProto(createdAt)
+		if err != nil {
+			return nil, err
+		}
+
+		txn.CreatedAt = ts
+
+		transactions = append(transactions, &txn)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// pageSize returns the effective page size for a requested size.
+func pageSize(requested int32) int {
+	switch {
+	case requested <= 0:
+		return defaultPageSize
+	case requested > maxPageSize:
+		return maxPageSize
+	default:
+		return int(requested)
+	}
+}
+
+// The listings page tokens are issued for.
+const (
+	transactionsPage = "t"
+	auditRecordsPage = "a"
+)
+
+// pageToken is the wire form of a page token: the ID of the last item of the
+// previous page, and the listing and account it was issued for.
+type pageToken struct {
+	List    string `json:"l"`
+	Account int64  `json:"c"`
+	After   int64  `json:"a"`
+}
+
+// encodePageToken returns an opaque token for a listing of an account,
+// positioned after the given item.
+func encodePageToken(list string, accountID, after int64) (string, error) {
+	encoded, err := json.Marshal(pageToken{List: list, Account: accountID, After: after})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(encoded), nil
+}
+
+// decodePageToken decodes a token previously returned by encodePageToken,
+// checking it was issued for the same listing and account, and returns the ID
+// the next page starts after.
+func decodePageToken(encoded, list string, accountID int64) (int64, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return 0, fmt.Errorf("%w: malformed token", ErrInvalidPageToken)
+	}
+
+	var token pageToken
+	if err := json.Unmarshal(decoded, &token); err != nil {
+		return 0, fmt.Errorf("%w: malformed token", ErrInvalidPageToken)
+	}
+
+	if token.List != list || token.Account != accountID {
+		return 0, fmt.Errorf("%w: token was issued for a different listing", ErrInvalidPageToken)
+	}
+
+	return token.After, nil
+}
@@ -0,0 +1,276 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"git.neds.sh/matty/entain/accounts/proto/accounts"
+)
+
+// openTestRepo opens an empty, initialised accounts repository in a
+// temporary SQLite database, returning the database too.
+func openTestRepo(t *testing.T) (AccountsRepo, *sql.DB) {
+	t.Helper()
+
+	db, err := Open(filepath.Join(t.TempDir(), "accounts.db"))
+	if err != nil {
+		t.Fatalf("opening database: %s", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	repo := NewAccountsRepo(db)
+	if err := repo.Init(context.Background()); err != nil {
+		t.Fatalf("initialising repository: %s", err)
+	}
+
+	return repo, db
+}
+
+// fundedAccount opens an account holding the given deposit.
+func fundedAccount(t *testing.T, repo AccountsRepo, deposit int64) int64 {
+	t.Helper()
+
+	account, err := repo.CreateAccount(context.Background(), "Test Customer")
+	if err != nil {
+		t.Fatalf("creating account: %s", err)
+	}
+
+	if _, err := repo.Deposit(context.Background(), account.Id, deposit, fmt.Sprintf("deposit-%d", account.Id)); err != nil {
+		t.Fatalf("depositing: %s", err)
+	}
+
+	return account.Id
+}
+
+// checkBalance fails the test unless an account has the balance given.
+func checkBalance(t *testing.T, repo AccountsRepo, accountID, available, held int64) {
+	t.Helper()
+
+	balance, err := repo.GetBalance(context.Background(), accountID)
+	if err != nil {
+		t.Fatalf("getting balance: %s", err)
+	}
+
+	if balance.Available != available || balance.Held != held {
+		t.Errorf("balance is %d available and %d held, want %d and %d", balance.Available, balance.Held, available, held)
+	}
+}
+
+// checkLedgerBalanced fails the test unless every ledger together sums to
+// zero.
+func checkLedgerBalanced(t *testing.T, db *sql.DB) {
+	t.Helper()
+
+	var sum int64
+	if err := db.QueryRow(`SELECT COALESCE(SUM(amount), 0) FROM entries`).Scan(&sum); err != nil {
+		t.Fatalf("summing entries: %s", err)
+	}
+
+	if sum != 0 {
+		t.Errorf("entries sum to %d, want 0", sum)
+	}
+}
+
+func TestAccountsRepoDepositAndWithdraw(t *testing.T) {
+	ctx := context.Background()
+	repo, db := openTestRepo(t)
+	id := fundedAccount(t, repo, 5000)
+
+	withdrawal, err := repo.Withdraw(ctx, id, 2000, "withdrawal-1")
+	if err != nil {
+		t.Fatalf("withdrawing: %s", err)
+	}
+
+	if withdrawal.Type != accounts.Transaction_WITHDRAWAL || withdrawal.Amount != 2000 || len(withdrawal.Entries) != 2 {
+		t.Errorf("withdrawal = %v, want a WITHDRAWAL of 2000 with two entries", withdrawal)
+	}
+
+	// Retrying the withdrawal returns it rather than withdrawing again.
+	retried, err := repo.Withdraw(ctx, id, 2000, "withdrawal-1")
+	if err != nil || retried.Id != withdrawal.Id {
+		t.Errorf("retrying withdrawal: got %v, %v, want transaction %d", retried, err, withdrawal.Id)
+	}
+
+	if _, err := repo.Withdraw(ctx, id, 500, "withdrawal-1"); !errors.Is(err, ErrReferenceReused) {
+		t.Errorf("reusing a reference: err = %v, want ErrReferenceReused", err)
+	}
+
+	if _, err := repo.Withdraw(ctx, id, 3001, "withdrawal-2"); !errors.Is(err, ErrInsufficientFunds) {
+		t.Errorf("overdrawing: err = %v, want ErrInsufficientFunds", err)
+	}
+
+	if _, err := repo.Deposit(ctx, id+1, 100, "deposit-x"); !errors.Is(err, ErrAccountNotFound) {
+		t.Errorf("depositing to a missing account: err = %v, want ErrAccountNotFound", err)
+	}
+
+	checkBalance(t, repo, id, 3000, 0)
+	checkLedgerBalanced(t, db)
+}
+
+func TestAccountsRepoStakes(t *testing.T) {
+	ctx := context.Background()
+	repo, db := openTestRepo(t)
+	id := fundedAccount(t, repo, 1000)
+
+	for _, reference := range []string{"bet-won", "bet-lost", "bet-refunded"} {
+		if _, err := repo.ReserveStake(ctx, id, 300, reference); err != nil {
+			t.Fatalf("reserving %s: %s", reference, err)
+		}
+	}
+
+	checkBalance(t, repo, id, 100, 900)
+
+	if _, err := repo.ReserveStake(ctx, id, 101, "bet-too-big"); !errors.Is(err, ErrInsufficientFunds) {
+		t.Errorf("reserving more than available: err = %v, want ErrInsufficientFunds", err)
+	}
+
+	if _, err := repo.PayoutStake(ctx, "bet-won", 750); err != nil {
+		t.Fatalf("paying out: %s", err)
+	}
+
+	if _, err := repo.PayoutStake(ctx, "bet-lost", 0); err != nil {
+		t.Fatalf("paying out a lost bet: %s", err)
+	}
+
+	if _, err := repo.RefundStake(ctx, "bet-refunded"); err != nil {
+		t.Fatalf("refunding: %s", err)
+	}
+
+	checkBalance(t, repo, id, 100+750+300, 0)
+
+	// Settling a stake again returns the settlement, and settling it another
+	// way fails.
+	if _, err := repo.PayoutStake(ctx, "bet-won", 750); err != nil {
+		t.Errorf("repeating a payout: %s", err)
+	}
+
+	if _, err := repo.PayoutStake(ctx, "bet-won", 900); !errors.Is(err, ErrReferenceReused) {
+		t.Errorf("paying out a different amount: err = %v, want ErrReferenceReused", err)
+	}
+
+	if _, err := repo.RefundStake(ctx, "bet-won"); !errors.Is(err, ErrStakeSettled) {
+		t.Errorf("refunding a stake paid out: err = %v, want ErrStakeSettled", err)
+	}
+
+	if _, err := repo.RefundStake(ctx, "bet-unknown"); !errors.Is(err, ErrStakeNotFound) {
+		t.Errorf("refunding an unknown stake: err = %v, want ErrStakeNotFound", err)
+	}
+
+	checkBalance(t, repo, id, 1150, 0)
+	checkLedgerBalanced(t, db)
+}
+
+func TestAccountsRepoConcurrentStakes(t *testing.T) {
+	ctx := context.Background()
+	repo, db := openTestRepo(t)
+	id := fundedAccount(t, repo, 1000)
+
+	// Twenty stakes of 100 race for a balance covering ten.
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		reserved int
+	)
+
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			_, err := repo.ReserveStake(ctx, id, 100, fmt.Sprintf("bet-%d", i))
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			switch {
+			case err == nil:
+				reserved++
+			case !errors.Is(err, ErrInsufficientFunds):
+				t.Errorf("reserving stake %d: %s", i, err)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	if reserved != 10 {
+		t.Errorf("reserved %d stakes, want 10", reserved)
+	}
+
+	checkBalance(t, repo, id, 0, 1000)
+	checkLedgerBalanced(t, db)
+}
+
+func TestAccountsRepoListTransactions(t *testing.T) {
+	ctx := context.Background()
+	repo, _ := openTestRepo(t)
+	id := fundedAccount(t, repo, 1000)
+	other := fundedAccount(t, repo, 1000)
+
+	for i := 0; i < 4; i++ {
+		if _, err := repo.ReserveStake(ctx, id, 100, fmt.Sprintf("bet-%d", i)); err != nil {
+			t.Fatalf("reserving stake: %s", err)
+		}
+	}
+
+	var (
+		got   []accounts.Transaction_Type
+		token string
+	)
+
+	for page := 0; page < 3; page++ {
+		transactions, next, err := repo.ListTransactions(ctx, &accounts.ListTransactionsRequest{AccountId: id, PageSize: 2, PageToken: token})
+		if err != nil {
+			t.Fatalf("listing page %d: %s", page, err)
+		}
+
+		for _, txn := range transactions {
+			if txn.AccountId != id {
+				t.Errorf("listed transaction %d of account %d", txn.Id, txn.AccountId)
+			}
+
+			got = append(got, txn.Type)
+		}
+
+		if token = next; token == "" {
+			break
+		}
+	}
+
+	want := []accounts.Transaction_Type{
+		accounts.Transaction_STAKE, accounts.Transaction_STAKE, accounts.Transaction_STAKE, accounts.Transaction_STAKE,
+		accounts.Transaction_DEPOSIT,
+	}
+
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("listed %v, want %v", got, want)
+	}
+
+	_, next, err := repo.ListTransactions(ctx, &accounts.ListTransactionsRequest{AccountId: id, PageSize: 1})
+	if err != nil {
+		t.Fatalf("listing: %s", err)
+	}
+
+	if _, _, err := repo.ListTransactions(ctx, &accounts.ListTransactionsRequest{AccountId: other, PageToken: next}); !errors.Is(err, ErrInvalidPageToken) {
+		t.Errorf("listing another account's page: err = %v, want ErrInvalidPageToken", err)
+	}
+}
+
+func TestAccountsRepoEntriesImmutable(t *testing.T) {
+	repo, db := openTestRepo(t)
+	fundedAccount(t, repo, 1000)
+
+	if _, err := db.Exec(`UPDATE entries SET amount = amount * 2`); err == nil {
+		t.Error("changing entries succeeded, want them immutable")
+	}
+
+	if _, err := db.Exec(`DELETE FROM transactions`); err == nil {
+		t.Error("deleting transactions succeeded, want them immutable")
+	}
+}
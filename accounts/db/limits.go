@@ -0,0 +1,726 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"git.neds.sh/matty/entain/accounts/proto/accounts"
+)
+
+// sessionTimeout is the longest gap between deposits and bets within a
+// session. A customer idle for longer starts a new session.
+const sessionTimeout = 30 * time.Minute
+
+var (
+	// ErrSelfExcluded is returned when an excluded account deposits or bets.
+	ErrSelfExcluded = errors.New("account is self-excluded")
+
+	// ErrLimitExceeded is returned when a deposit or stake would take an
+	// account over one of its limits.
+	ErrLimitExceeded = errors.New("limit exceeded")
+
+	// ErrReminderDue is returned when an account deposits or bets while its
+	// session reminder is due.
+	ErrReminderDue = errors.New("session reminder due")
+
+	// ErrExclusionShortened is returned when excluding an account already
+	// excluded for longer.
+	ErrExclusionShortened = errors.New("exclusion cannot be shortened")
+)
+
+// windows are the rolling windows deposits and losses are limited over.
+var windows = map[accounts.Limit_Period]time.Duration{
+	accounts.Limit_DAY:   24 * time.Hour,
+	accounts.Limit_WEEK:  7 * 24 * time.Hour,
+	accounts.Limit_MONTH: 30 * 24 * time.Hour,
+}
+
+// limitKey identifies a limit: an account has at most one of each type and
+// period.
+type limitKey struct {
+	limitType accounts.Limit_Type
+	period    accounts.Limit_Period
+}
+
+// limitState is the state of an account's limits at a time, derived from its
+// audit records.
+type limitState struct {
+	// effective are the values of the limits in effect, in cents or minutes.
+	effective map[limitKey]int64
+	// pending are the changes raising or removing limits that are yet to
+	// take effect.
+	pending map[limitKey]*accounts.AuditRecord
+	// excludedUntil is the end of the account's exclusion, if any.
+	excludedUntil time.Time
+}
+
+func (r *accountsRepo) GetLimits(ctx context.Context, accountID int64) (*accounts.Limits, error) {
+	if _, err := getAccount(ctx, r.db, accountID); err != nil {
+		return nil, err
+	}
+
+	now := r.clock()
+
+	state, err := loadLimitState(ctx, r.db, accountID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := &accounts.Limits{AccountId: accountID}
+
+	for key, value := range state.effective {
+		limits.Limits = append(limits.Limits, limitOf(key, value))
+	}
+
+	sort.Slice(limits.Limits, func(i, j int) bool {
+		return lessKey(keyOf(limits.Limits[i]), keyOf(limits.Limits[j]))
+	})
+
+	for _, record := range state.pending {
+		limits.Pending = append(limits.Pending, record)
+	}
+
+	sort.Slice(limits.Pending, func(i, j int) bool { return limits.Pending[i].Id < limits.Pending[j].Id })
+
+	if state.excludedUntil.After(now) {
+		if limits.ExcludedUntil, err = ptypes.TimestampProto(state.excludedUntil); err != nil {
+			return nil, err
+		}
+	}
+
+	return limits, nil
+}
+
+func (r *accountsRepo) SetLimit(ctx context.Context, accountID int64, limit *accounts.Limit, coolingOff time.Duration) (*accounts.AuditRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var (
+		record *accounts.AuditRecord
+		now    = r.clock()
+	)
+
+	if err := inTx(ctx, r.db, func(tx *sql.Tx) error {
+		if _, err := getAccount(ctx, tx, accountID); err != nil {
+			return err
+		}
+
+		state, err := loadLimitState(ctx, tx, accountID, now)
+		if err != nil {
+			return err
+		}
+
+		key, value := keyOf(limit), valueOf(limit)
+		current, limited := state.effective[key]
+
+		// A value of zero is no limit at all, so loosens any limit.
+		tightened := value != 0 && (!limited || value < current)
+		unchanged := value == current
+
+		effectiveAt := now
+		if !tightened && !unchanged {
+			effectiveAt = now.Add(coolingOff)
+		}
+
+		var previous sql.NullInt64
+		if limited {
+			previous = sql.NullInt64{Int64: current, Valid: true}
+		}
+
+		record, err = insertAuditRecord(ctx, tx, accountID, accounts.AuditRecord_LIMIT_CHANGED, key, previous, value, time.Time{}, now, effectiveAt)
+
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (r *accountsRepo) SelfExclude(ctx context.Context, accountID int64, duration time.Duration) (*accounts.AuditRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var (
+		record *accounts.AuditRecord
+		now    = r.clock()
+	)
+
+	if err := inTx(ctx, r.db, func(tx *sql.Tx) error {
+		if _, err := getAccount(ctx, tx, accountID); err != nil {
+			return err
+		}
+
+		state, err := loadLimitState(ctx, tx, accountID, now)
+		if err != nil {
+			return err
+		}
+
+		until := now.Add(duration)
+		if state.excludedUntil.After(until) {
+			return fmt.Errorf("%w: account %d is already excluded until %s", ErrExclusionShortened, accountID, state.excludedUntil.Format(time.RFC3339))
+		}
+
+		record, err = insertAuditRecord(ctx, tx, accountID, accounts.AuditRecord_SELF_EXCLUDED, limitKey{}, sql.NullInt64{}, 0, until, now, now)
+
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (r *accountsRepo) ListAuditRecords(ctx context.Context, in *accounts.ListAuditRecordsRequest) ([]*accounts.AuditRecord, string, error) {
+	if _, err := getAccount(ctx, r.db, in.AccountId); err != nil {
+		return nil, "", err
+	}
+
+	query := getAccountQueries()[auditRecordsList] + " WHERE account_id = ?"
+	args := []interface{}{in.AccountId}
+
+	if in.PageToken != "" {
+		after, err := decodePageToken(in.PageToken, auditRecordsPage, in.AccountId)
+		if err != nil {
+			return nil, "", err
+		}
+
+		query += " AND id < ?"
+		args = append(args, after)
+	}
+
+	// One record more than a page is fetched, to tell whether another page
+	// follows.
+	size := pageSize(in.PageSize)
+	query += " ORDER BY id DESC LIMIT ?"
+	args = append(args, size+1)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	records, err := scanAuditRecords(rows)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(records) <= size {
+		return records, "", nil
+	}
+
+	records = records[:size]
+
+	next, err := encodePageToken(auditRecordsPage, in.AccountId, records[size-1].Id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return records, next, nil
+}
+
+func (r *accountsRepo) GetSession(ctx context.Context, accountID int64) (*accounts.Session, error) {
+	if _, err := getAccount(ctx, r.db, accountID); err != nil {
+		return nil, err
+	}
+
+	now := r.clock()
+
+	state, err := loadLimitState(ctx, r.db, accountID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := getSession(ctx, r.db, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return current.toProto(accountID, state.reminderInterval(), now)
+}
+
+func (r *accountsRepo) AcknowledgeReminder(ctx context.Context, accountID int64) (*accounts.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var (
+		acknowledged *accounts.Session
+		now          = r.clock()
+	)
+
+	if err := inTx(ctx, r.db, func(tx *sql.Tx) error {
+		if _, err := getAccount(ctx, tx, accountID); err != nil {
+			return err
+		}
+
+		state, err := loadLimitState(ctx, tx, accountID, now)
+		if err != nil {
+			return err
+		}
+
+		current, err := getSession(ctx, tx, accountID)
+		if err != nil {
+			return err
+		}
+
+		interval := state.reminderInterval()
+
+		// Only a reminder that is due is acknowledged, so the next is never
+		// put off by acknowledging early.
+		if current.reminderDue(interval, now) {
+			if _, err := tx.ExecContext(ctx, getAccountQueries()[sessionsAcknowledge], now.Format(time.RFC3339), now.Format(time.RFC3339), accountID); err != nil {
+				return err
+			}
+
+			current.acknowledgedAt = sql.NullTime{Time: now, Valid: true}
+			current.lastActiveAt = now
+		}
+
+		acknowledged, err = current.toProto(accountID, interval, now)
+
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return acknowledged, nil
+}
+
+// checkPlay checks that an account may make a deposit or stake of an amount:
+// that it is not excluded, that the amount keeps it within its deposit or
+// loss limits, and that no session reminder is due.
+func checkPlay(ctx context.Context, tx *sql.Tx, txnType accounts.Transaction_Type, accountID, amount int64, now time.Time) error {
+	state, err := loadLimitState(ctx, tx, accountID, now)
+	if err != nil {
+		return err
+	}
+
+	if state.excludedUntil.After(now) {
+		return fmt.Errorf("%w: account %d is excluded until %s", ErrSelfExcluded, accountID, state.excludedUntil.Format(time.RFC3339))
+	}
+
+	keys := make([]limitKey, 0, len(state.effective))
+	for key := range state.effective {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return lessKey(keys[i], keys[j]) })
+
+	for _, key := range keys {
+		limit := state.effective[key]
+		since := now.Add(-windows[key.period])
+
+		switch {
+		case key.limitType == accounts.Limit_DEPOSIT && txnType == accounts.Transaction_DEPOSIT:
+			deposited, err := sumSince(ctx, tx, accountID, accounts.Transaction_DEPOSIT, since)
+			if err != nil {
+				return err
+			}
+
+			if deposited+amount > limit {
+				return fmt.Errorf("%w: the %s deposit limit is %d cents, and %d have been deposited", ErrLimitExceeded, periodName(key.period), limit, deposited)
+			}
+		case key.limitType == accounts.Limit_LOSS && txnType == accounts.Transaction_STAKE:
+			lost, err := lossSince(ctx, tx, accountID, since)
+			if err != nil {
+				return err
+			}
+
+			// The stake is counted as lost, as it may be.
+			if lost+amount > limit {
+				return fmt.Errorf("%w: the %s loss limit is %d cents, and %d have been lost or staked", ErrLimitExceeded, periodName(key.period), limit, lost)
+			}
+		}
+	}
+
+	current, err := getSession(ctx, tx, accountID)
+	if err != nil {
+		return err
+	}
+
+	if current.reminderDue(state.reminderInterval(), now) {
+		return fmt.Errorf("%w: account %d has been playing for %d minutes, and must acknowledge the reminder to carry on", ErrReminderDue, accountID, int64(now.Sub(current.startedAt).Minutes()))
+	}
+
+	return nil
+}
+
+// sumSince returns the sum of an account's transactions of a type since a
+// time.
+func sumSince(ctx context.Context, tx *sql.Tx, accountID int64, txnType accounts.Transaction_Type, since time.Time) (int64, error) {
+	var sum int64
+
+	err := tx.QueryRowContext(ctx, getAccountQueries()[transactionsSince], accountID, txnType.String(), since.Format(time.RFC3339)).Scan(&sum)
+
+	return sum, err
+}
+
+// lossSince returns an account's stakes less the payouts and refunds made to
+// it since a time. It is negative while the account is winning.
+func lossSince(ctx context.Context, tx *sql.Tx, accountID int64, since time.Time) (int64, error) {
+	var loss int64
+
+	for _, txnType := range []accounts.Transaction_Type{accounts.Transaction_STAKE, accounts.Transaction_PAYOUT, accounts.Transaction_REFUND} {
+		sum, err := sumSince(ctx, tx, accountID, txnType, since)
+		if err != nil {
+			return 0, err
+		}
+
+		if txnType == accounts.Transaction_STAKE {
+			loss += sum
+		} else {
+			loss -= sum
+		}
+	}
+
+	return loss, nil
+}
+
+// loadLimitState derives the state of an account's limits at a time from its
+// audit records, replaying them in order. Each change to a limit supersedes
+// any change to it still pending, and a pending change takes effect once its
+// effective time has passed.
+func loadLimitState(ctx context.Context, q querier, accountID int64, now time.Time) (*limitState, error) {
+	rows, err := q.QueryContext(ctx, getAccountQueries()[auditRecordsList]+" WHERE account_id = ? ORDER BY id", accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := scanAuditRecords(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &limitState{
+		effective: make(map[limitKey]int64),
+		pending:   make(map[limitKey]*accounts.AuditRecord),
+	}
+
+	for _, record := range records {
+		if record.Action == accounts.AuditRecord_SELF_EXCLUDED {
+			if until := record.ExcludedUntil.AsTime(); until.After(state.excludedUntil) {
+				state.excludedUntil = until
+			}
+
+			continue
+		}
+
+		key := keyOf(record.Limit)
+		createdAt := record.CreatedAt.AsTime()
+
+		state.applyPending(key, createdAt)
+
+		if record.EffectiveAt.AsTime().After(createdAt) {
+			state.pending[key] = record
+		} else {
+			state.set(key, valueOf(record.Limit))
+			delete(state.pending, key)
+		}
+	}
+
+	for key := range state.pending {
+		state.applyPending(key, now)
+	}
+
+	return state, nil
+}
+
+// applyPending applies the pending change to a limit should it have taken
+// effect by the given time.
+func (s *limitState) applyPending(key limitKey, at time.Time) {
+	if record, ok := s.pending[key]; ok && !record.EffectiveAt.AsTime().After(at) {
+		s.set(key, valueOf(record.Limit))
+		delete(s.pending, key)
+	}
+}
+
+// set sets the value of a limit, removing it for a value of zero.
+func (s *limitState) set(key limitKey, value int64) {
+	if value == 0 {
+		delete(s.effective, key)
+	} else {
+		s.effective[key] = value
+	}
+}
+
+// reminderInterval returns the interval between session reminders, or zero
+// when the account has no SESSION limit.
+func (s *limitState) reminderInterval() time.Duration {
+	return time.Duration(s.effective[limitKey{limitType: accounts.Limit_SESSION}]) * time.Minute
+}
+
+// session is the current session of an account. A nil session is none.
+type session struct {
+	startedAt      time.Time
+	lastActiveAt   time.Time
+	acknowledgedAt sql.NullTime
+}
+
+// active reports whether the session is still going at the given time.
+func (s *session) active(now time.Time) bool {
+	return s != nil && now.Sub(s.lastActiveAt) <= sessionTimeout
+}
+
+// nextReminder returns the time the next reminder is due, the given interval
+// after the session started or its last reminder was acknowledged.
+func (s *session) nextReminder(interval time.Duration) time.Time {
+	from := s.startedAt
+	if s.acknowledgedAt.Valid && s.acknowledgedAt.Time.After(from) {
+		from = s.acknowledgedAt.Time
+	}
+
+	return from.Add(interval)
+}
+
+// reminderDue reports whether a reminder is due at the given time.
+func (s *session) reminderDue(interval time.Duration, now time.Time) bool {
+	return interval > 0 && s.active(now) && !now.Before(s.nextReminder(interval))
+}
+
+// toProto returns the session as it is at the given time.
+func (s *session) toProto(accountID int64, interval time.Duration, now time.Time) (*accounts.Session, error) {
+	current := &accounts.Session{AccountId: accountID}
+
+	if !s.active(now) {
+		return current, nil
+	}
+
+	var err error
+
+	if current.StartedAt, err = ptypes.TimestampProto(s.startedAt); err != nil {
+		return nil, err
+	}
+
+	if current.LastActiveAt, err = ptypes.TimestampProto(s.lastActiveAt); err != nil {
+		return nil, err
+	}
+
+	if interval > 0 {
+		current.ReminderDue = s.reminderDue(interval, now)
+
+		if current.NextReminderAt, err = ptypes.TimestampProto(s.nextReminder(interval)); err != nil {
+			return nil, err
+		}
+	}
+
+	return current, nil
+}
+
+// getSession returns the latest session of an account, or nil if it has
+// never had one.
+func getSession(ctx context.Context, q querier, accountID int64) (*session, error) {
+	var s session
+
+	if err := q.QueryRowContext(ctx, getAccountQueries()[sessionsGet], accountID).Scan(&s.startedAt, &s.lastActiveAt, &s.acknowledgedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// touchSession records activity on an account at the given time, starting a
+// new session should the last have ended.
+func touchSession(ctx context.Context, tx *sql.Tx, accountID int64, now time.Time) error {
+	current, err := getSession(ctx, tx, accountID)
+	if err != nil {
+		return err
+	}
+
+	if current.active(now) {
+		_, err = tx.ExecContext(ctx, getAccountQueries()[sessionsTouch], now.Format(time.RFC3339), accountID)
+	} else {
+		_, err = tx.ExecContext(ctx, getAccountQueries()[sessionsStart], accountID, now.Format(time.RFC3339), now.Format(time.RFC3339))
+	}
+
+	return err
+}
+
+// insertAuditRecord records a change to an account's limits or exclusion,
+// returning its record.
+func insertAuditRecord(
+	ctx context.Context,
+	tx *sql.Tx,
+	accountID int64,
+	action accounts.AuditRecord_Action,
+	key limitKey,
+	previous sql.NullInt64,
+	value int64,
+	excludedUntil, createdAt, effectiveAt time.Time,
+) (*accounts.AuditRecord, error) {
+	var (
+		limitType, period string
+		until             sql.NullString
+	)
+
+	if action == accounts.AuditRecord_LIMIT_CHANGED {
+		limitType, period = key.limitType.String(), key.period.String()
+	} else {
+		until = sql.NullString{String: excludedUntil.Format(time.RFC3339), Valid: true}
+	}
+
+	result, err := tx.ExecContext(ctx, getAccountQueries()[auditRecordsCreate],
+		accountID,
+		action.String(),
+		limitType,
+		period,
+		previous,
+		value,
+		until,
+		createdAt.Format(time.RFC3339),
+		effectiveAt.Format(time.RFC3339),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, getAccountQueries()[auditRecordsList]+" WHERE id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := scanAuditRecords(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return records[0], nil
+}
+
+func scanAuditRecords(rows *sql.Rows) ([]*accounts.AuditRecord, error) {
+	defer rows.Close()
+
+	var records []*accounts.AuditRecord
+
+	for rows.Next() {
+		var (
+			record                    accounts.AuditRecord
+			action, limitType, period string
+			previous                  sql.NullInt64
+			value                     int64
+			excludedUntil             sql.NullTime
+			createdAt, effectiveAt    time.Time
+		)
+
+		if err := rows.Scan(
+			&record.Id,
+			&record.AccountId,
+			&action,
+			&limitType,
+			&period,
+			&previous,
+			&value,
+			&excludedUntil,
+			&createdAt,
+			&effectiveAt,
+		); err != nil {
+			return nil, err
+		}
+
+		record.Action = accounts.AuditRecord_Action(accounts.AuditRecord_Action_value[action])
+
+		var err error
+
+		if record.Action == accounts.AuditRecord_LIMIT_CHANGED {
+			key := limitKey{
+				limitType: accounts.Limit_Type(accounts.Limit_Type_value[limitType]),
+				period:    accounts.Limit_Period(accounts.Limit_Period_value[period]),
+			}
+
+			record.Limit = limitOf(key, value)
+
+			if previous.Valid {
+				record.Previous = limitOf(key, previous.Int64)
+			}
+		}
+
+		if excludedUntil.Valid {
+			if record.ExcludedUntil, err = ptypes.TimestampProto(excludedUntil.Time); err != nil {
+				return nil, err
+			}
+		}
+
+		if record.CreatedAt, err = ptypes.TimestampProto(createdAt); err != nil {
+			return nil, err
+		}
+
+		if record.EffectiveAt, err = ptypes.TimestampProto(effectiveAt); err != nil {
+			return nil, err
+		}
+
+		records = append(records, &record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// keyOf returns the key of a limit. SESSION limits have no period.
+func keyOf(limit *accounts.Limit) limitKey {
+	if limit.Type == accounts.Limit_SESSION {
+		return limitKey{limitType: limit.Type}
+	}
+
+	return limitKey{limitType: limit.Type, period: limit.Period}
+}
+
+// valueOf returns the value of a limit: its amount in cents, or its minutes
+// for a SESSION limit.
+func valueOf(limit *accounts.Limit) int64 {
+	if limit.Type == accounts.Limit_SESSION {
+		return limit.Minutes
+	}
+
+	return limit.Amount
+}
+
+// limitOf returns the limit of a key with the given value.
+func limitOf(key limitKey, value int64) *accounts.Limit {
+	limit := &accounts.Limit{Type: key.limitType, Period: key.period}
+
+	if key.limitType == accounts.Limit_SESSION {
+		limit.Minutes = value
+	} else {
+		limit.Amount = value
+	}
+
+	return limit
+}
+
+// lessKey orders limits by type, then period.
+func lessKey(a, b limitKey) bool {
+	if a.limitType != b.limitType {
+		return a.limitType < b.limitType
+	}
+
+	return a.period < b.period
+}
+
+// periodName describes a period in an error.
+func periodName(period accounts.Limit_Period) string {
+	switch period {
+	case accounts.Limit_DAY:
+		return "daily"
+	case accounts.Limit_WEEK:
+		return "weekly"
+	default:
+		return "monthly"
+	}
+}
@@ -0,0 +1,325 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"git.neds.sh/matty/entain/accounts/proto/accounts"
+)
+
+// fakeClock is a clock tests move forwards by hand.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) advance(d time.Duration) { c.now = c.now.Add(d) }
+
+// withFakeClock makes a repository tell the time by a fake clock, returning
+// the clock.
+func withFakeClock(repo AccountsRepo) *fakeClock {
+	clock := &fakeClock{now: time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)}
+	repo.(*accountsRepo).now = clock.Now
+
+	return clock
+}
+
+// setLimit sets a limit on an account, failing the test should it fail.
+func setLimit(t *testing.T, repo AccountsRepo, accountID int64, limit *accounts.Limit, coolingOff time.Duration) *accounts.AuditRecord {
+	t.Helper()
+
+	record, err := repo.SetLimit(context.Background(), accountID, limit, coolingOff)
+	if err != nil {
+		t.Fatalf("setting limit %v: %s", limit, err)
+	}
+
+	return record
+}
+
+// checkLimits fails the test unless an account has the limits given in
+// effect.
+func checkLimits(t *testing.T, repo AccountsRepo, accountID int64, want ...*accounts.Limit) *accounts.Limits {
+	t.Helper()
+
+	limits, err := repo.GetLimits(context.Background(), accountID)
+	if err != nil {
+		t.Fatalf("getting limits: %s", err)
+	}
+
+	if fmt.Sprint(limits.Limits) != fmt.Sprint(want) {
+		t.Errorf("limits are %v, want %v", limits.Limits, want)
+	}
+
+	return limits
+}
+
+func TestAccountsRepoCoolingOff(t *testing.T) {
+	repo, _ := openTestRepo(t)
+	clock := withFakeClock(repo)
+	id := fundedAccount(t, repo, 1000)
+
+	daily := func(amount int64) *accounts.Limit {
+		return &accounts.Limit{Type: accounts.Limit_DEPOSIT, Period: accounts.Limit_DAY, Amount: amount}
+	}
+
+	// Setting and lowering a limit takes effect at once.
+	setLimit(t, repo, id, daily(5000), time.Hour)
+	lowered := setLimit(t, repo, id, daily(3000), time.Hour)
+
+	if lowered.Previous.Amount != 5000 || !lowered.EffectiveAt.AsTime().Equal(clock.now) {
+		t.Errorf("lowering = %v, want a change from 5000 effective now", lowered)
+	}
+
+	checkLimits(t, repo, id, daily(3000))
+
+	// Raising it waits out the cooling-off period.
+	raised := setLimit(t, repo, id, daily(8000), time.Hour)
+
+	if !raised.EffectiveAt.AsTime().Equal(clock.now.Add(time.Hour)) {
+		t.Errorf("raising takes effect at %s, want an hour from now", raised.EffectiveAt.AsTime())
+	}
+
+	if limits := checkLimits(t, repo, id, daily(3000)); len(limits.Pending) != 1 || limits.Pending[0].Id != raised.Id {
+		t.Errorf("pending changes are %v, want the raise", limits.Pending)
+	}
+
+	clock.advance(time.Hour)
+	checkLimits(t, repo, id, daily(8000))
+
+	// Removing it waits too, and lowering it meanwhile cancels the removal.
+	setLimit(t, repo, id, daily(0), time.Hour)
+	clock.advance(30 * time.Minute)
+	setLimit(t, repo, id, daily(6000), time.Hour)
+	clock.advance(time.Hour)
+
+	if limits := checkLimits(t, repo, id, daily(6000)); len(limits.Pending) != 0 {
+		t.Errorf("pending changes are %v, want none", limits.Pending)
+	}
+
+	setLimit(t, repo, id, daily(0), time.Hour)
+	clock.advance(time.Hour)
+	checkLimits(t, repo, id)
+
+	records, _, err := repo.ListAuditRecords(context.Background(), &accounts.ListAuditRecordsRequest{AccountId: id})
+	if err != nil {
+		t.Fatalf("listing audit records: %s", err)
+	}
+
+	if len(records) != 6 || records[0].Limit.Amount != 0 || records[5].Limit.Amount != 5000 || records[5].Previous != nil {
+		t.Errorf("audit records are %v, want the six changes, newest first", records)
+	}
+}
+
+func TestAccountsRepoDepositLimit(t *testing.T) {
+	ctx := context.Background()
+	repo, _ := openTestRepo(t)
+	clock := withFakeClock(repo)
+	id := fundedAccount(t, repo, 1000)
+
+	setLimit(t, repo, id, &accounts.Limit{Type: accounts.Limit_DEPOSIT, Period: accounts.Limit_DAY, Amount: 1500}, time.Hour)
+
+	if _, err := repo.Deposit(ctx, id, 501, "deposit-2"); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("depositing over the limit: err = %v, want ErrLimitExceeded", err)
+	}
+
+	if _, err := repo.Deposit(ctx, id, 500, "deposit-2"); err != nil {
+		t.Errorf("depositing up to the limit: %s", err)
+	}
+
+	// Withdrawing does not make room for more deposits, but time does.
+	if _, err := repo.Withdraw(ctx, id, 1500, "withdrawal-1"); err != nil {
+		t.Fatalf("withdrawing: %s", err)
+	}
+
+	if _, err := repo.Deposit(ctx, id, 1, "deposit-3"); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("depositing after withdrawing: err = %v, want ErrLimitExceeded", err)
+	}
+
+	clock.advance(24 * time.Hour)
+
+	if _, err := repo.Deposit(ctx, id, 1500, "deposit-3"); err != nil {
+		t.Errorf("depositing a day later: %s", err)
+	}
+}
+
+func TestAccountsRepoLossLimit(t *testing.T) {
+	ctx := context.Background()
+	repo, db := openTestRepo(t)
+	clock := withFakeClock(repo)
+	id := fundedAccount(t, repo, 10000)
+
+	setLimit(t, repo, id, &accounts.Limit{Type: accounts.Limit_LOSS, Period: accounts.Limit_WEEK, Amount: 1000}, time.Hour)
+
+	for _, reference := range []string{"bet-lost", "bet-won", "bet-refunded"} {
+		if _, err := repo.ReserveStake(ctx, id, 300, reference); err != nil {
+			t.Fatalf("reserving %s: %s", reference, err)
+		}
+	}
+
+	// Open stakes count against the limit.
+	if _, err := repo.ReserveStake(ctx, id, 101, "bet-too-big"); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("staking over the limit: err = %v, want ErrLimitExceeded", err)
+	}
+
+	// Winnings and refunds make room for more stakes.
+	if _, err := repo.PayoutStake(ctx, "bet-lost", 0); err != nil {
+		t.Fatalf("paying out: %s", err)
+	}
+
+	if _, err := repo.PayoutStake(ctx, "bet-won", 500); err != nil {
+		t.Fatalf("paying out: %s", err)
+	}
+
+	if _, err := repo.RefundStake(ctx, "bet-refunded"); err != nil {
+		t.Fatalf("refunding: %s", err)
+	}
+
+	// 900 staked less 800 returned is a loss of 100.
+	if _, err := repo.ReserveStake(ctx, id, 900, "bet-4"); err != nil {
+		t.Errorf("staking up to the limit: %s", err)
+	}
+
+	if _, err := repo.ReserveStake(ctx, id, 1, "bet-5"); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("staking over the limit: err = %v, want ErrLimitExceeded", err)
+	}
+
+	clock.advance(7 * 24 * time.Hour)
+
+	if _, err := repo.ReserveStake(ctx, id, 1000, "bet-5"); err != nil {
+		t.Errorf("staking a week later: %s", err)
+	}
+
+	checkLedgerBalanced(t, db)
+}
+
+func TestAccountsRepoSelfExclude(t *testing.T) {
+	ctx := context.Background()
+	repo, _ := openTestRepo(t)
+	clock := withFakeClock(repo)
+	id := fundedAccount(t, repo, 1000)
+
+	if _, err := repo.ReserveStake(ctx, id, 100, "bet-open"); err != nil {
+		t.Fatalf("reserving stake: %s", err)
+	}
+
+	if _, err := repo.SelfExclude(ctx, id, 7*24*time.Hour); err != nil {
+		t.Fatalf("excluding: %s", err)
+	}
+
+	if _, err := repo.SelfExclude(ctx, id, 24*time.Hour); !errors.Is(err, ErrExclusionShortened) {
+		t.Errorf("shortening the exclusion: err = %v, want ErrExclusionShortened", err)
+	}
+
+	if _, err := repo.Deposit(ctx, id, 100, "deposit-2"); !errors.Is(err, ErrSelfExcluded) {
+		t.Errorf("depositing while excluded: err = %v, want ErrSelfExcluded", err)
+	}
+
+	if _, err := repo.ReserveStake(ctx, id, 100, "bet-new"); !errors.Is(err, ErrSelfExcluded) {
+		t.Errorf("staking while excluded: err = %v, want ErrSelfExcluded", err)
+	}
+
+	// Open bets are still settled, and the money withdrawn.
+	if _, err := repo.RefundStake(ctx, "bet-open"); err != nil {
+		t.Errorf("refunding while excluded: %s", err)
+	}
+
+	if _, err := repo.Withdraw(ctx, id, 1000, "withdrawal-1"); err != nil {
+		t.Errorf("withdrawing while excluded: %s", err)
+	}
+
+	limits, err := repo.GetLimits(ctx, id)
+	if err != nil {
+		t.Fatalf("getting limits: %s", err)
+	}
+
+	if want := clock.now.Add(7 * 24 * time.Hour); !limits.ExcludedUntil.AsTime().Equal(want) {
+		t.Errorf("excluded until %v, want %s", limits.ExcludedUntil, want)
+	}
+
+	clock.advance(7 * 24 * time.Hour)
+
+	if _, err := repo.Deposit(ctx, id, 100, "deposit-2"); err != nil {
+		t.Errorf("depositing after the exclusion: %s", err)
+	}
+}
+
+func TestAccountsRepoSessionReminder(t *testing.T) {
+	ctx := context.Background()
+	repo, _ := openTestRepo(t)
+	clock := withFakeClock(repo)
+	id := fundedAccount(t, repo, 10000)
+
+	setLimit(t, repo, id, &accounts.Limit{Type: accounts.Limit_SESSION, Minutes: 60}, time.Hour)
+
+	stake := func(reference string) error {
+		_, err := repo.ReserveStake(ctx, id, 100, reference)
+
+		return err
+	}
+
+	// The deposit started a session, and bets every twenty minutes keep it
+	// going until the reminder.
+	for i := 0; i < 2; i++ {
+		clock.advance(20 * time.Minute)
+
+		if err := stake(fmt.Sprintf("bet-%d", i)); err != nil {
+			t.Fatalf("staking at %s: %s", clock.now, err)
+		}
+	}
+
+	clock.advance(20 * time.Minute)
+
+	if err := stake("bet-3"); !errors.Is(err, ErrReminderDue) {
+		t.Errorf("staking once the reminder is due: err = %v, want ErrReminderDue", err)
+	}
+
+	current, err := repo.GetSession(ctx, id)
+	if err != nil {
+		t.Fatalf("getting session: %s", err)
+	}
+
+	if !current.ReminderDue {
+		t.Errorf("session = %v, want a reminder due", current)
+	}
+
+	if current, err = repo.AcknowledgeReminder(ctx, id); err != nil || current.ReminderDue {
+		t.Fatalf("acknowledging: got %v, %v, want no reminder due", current, err)
+	}
+
+	if err := stake("bet-3"); err != nil {
+		t.Errorf("staking after acknowledging: %s", err)
+	}
+
+	// The next reminder is due an hour after acknowledging, unless the
+	// session ends first.
+	clock.advance(45 * time.Minute)
+
+	if err := stake("bet-4"); err != nil {
+		t.Errorf("staking after a break: %s", err)
+	}
+
+	clock.advance(59 * time.Minute)
+
+	if err := stake("bet-5"); err != nil {
+		t.Errorf("staking within the new session: %s", err)
+	}
+}
+
+func TestAccountsRepoAuditRecordsImmutable(t *testing.T) {
+	repo, db := openTestRepo(t)
+	id := fundedAccount(t, repo, 1000)
+
+	setLimit(t, repo, id, &accounts.Limit{Type: accounts.Limit_DEPOSIT, Period: accounts.Limit_DAY, Amount: 1000}, time.Hour)
+
+	if _, err := db.Exec(`UPDATE audit_records SET effective_at = created_at`); err == nil {
+		t.Error("changing audit records succeeded, want them immutable")
+	}
+
+	if _, err := db.Exec(`DELETE FROM audit_records`); err == nil {
+		t.Error("deleting audit records succeeded, want them immutable")
+	}
+}
@@ -0,0 +1,58 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestMigratorDownAndUp reverts every migration of a database holding a
+// funded account, then applies them again.
+func TestMigratorDownAndUp(t *testing.T) {
+	ctx := context.Background()
+	repo, db := openTestRepo(t)
+
+	fundedAccount(t, repo, 1000)
+
+	migrator, err := NewMigrator(db)
+	if err != nil {
+		t.Fatalf("creating migrator: %s", err)
+	}
+
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		t.Fatalf("listing migrations: %s", err)
+	}
+
+	for _, status := range statuses {
+		if !status.Applied {
+			t.Errorf("migration %04d_%s is pending after initialising", status.Version, status.Name)
+		}
+	}
+
+	for i := len(statuses) - 1; i >= 0; i-- {
+		reverted, err := migrator.Down(ctx)
+		if err != nil {
+			t.Fatalf("reverting migration %04d_%s: %s", statuses[i].Version, statuses[i].Name, err)
+		}
+
+		if reverted.Version != statuses[i].Version {
+			t.Errorf("reverted migration %d, want %d", reverted.Version, statuses[i].Version)
+		}
+	}
+
+	if _, err := migrator.Down(ctx); !errors.Is(err, ErrNoMigrations) {
+		t.Errorf("reverting with none applied: err = %v, want ErrNoMigrations", err)
+	}
+
+	migrated, err := migrator.Up(ctx)
+	if err != nil {
+		t.Fatalf("applying migrations: %s", err)
+	}
+
+	if len(migrated) != len(statuses) {
+		t.Errorf("applied %d migrations, want %d", len(migrated), len(statuses))
+	}
+
+	fundedAccount(t, repo, 1000)
+}
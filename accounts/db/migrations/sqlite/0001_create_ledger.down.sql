@@ -0,0 +1,5 @@
+-- Dropping a table does not fire its triggers, so the ledger can be dropped
+-- though its rows cannot be deleted.
+DROP TABLE IF EXISTS entries;
+DROP TABLE IF EXISTS transactions;
+DROP TABLE IF EXISTS accounts;
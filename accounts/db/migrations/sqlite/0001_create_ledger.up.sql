@@ -0,0 +1,50 @@
+CREATE TABLE IF NOT EXISTS accounts (
+	id INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	created_at DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS transactions (
+	id INTEGER PRIMARY KEY,
+	account_id INTEGER NOT NULL REFERENCES accounts(id),
+	type TEXT NOT NULL,
+	amount INTEGER NOT NULL,
+	reference TEXT NOT NULL,
+	created_at DATETIME NOT NULL,
+	UNIQUE(type, reference)
+);
+
+-- A stake is settled once, by either a payout or a refund.
+CREATE UNIQUE INDEX transactions_settled_reference ON transactions(reference) WHERE type IN ('PAYOUT', 'REFUND');
+CREATE INDEX transactions_account_id ON transactions(account_id, id);
+
+CREATE TABLE IF NOT EXISTS entries (
+	id INTEGER PRIMARY KEY,
+	transaction_id INTEGER NOT NULL REFERENCES transactions(id),
+	ledger TEXT NOT NULL,
+	amount INTEGER NOT NULL
+);
+
+CREATE INDEX entries_transaction_id ON entries(transaction_id);
+CREATE INDEX entries_ledger ON entries(ledger);
+
+-- Balances are derived from the ledger, so it is only ever added to.
+CREATE TRIGGER transactions_immutable_update BEFORE UPDATE ON transactions
+BEGIN
+	SELECT RAISE(ABORT, 'transactions cannot be changed');
+END;
+
+CREATE TRIGGER transactions_immutable_delete BEFORE DELETE ON transactions
+BEGIN
+	SELECT RAISE(ABORT, 'transactions cannot be deleted');
+END;
+
+CREATE TRIGGER entries_immutable_update BEFORE UPDATE ON entries
+BEGIN
+	SELECT RAISE(ABORT, 'entries cannot be changed');
+END;
+
+CREATE TRIGGER entries_immutable_delete BEFORE DELETE ON entries
+BEGIN
+	SELECT RAISE(ABORT, 'entries cannot be deleted');
+END;
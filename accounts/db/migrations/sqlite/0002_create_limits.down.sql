@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS transactions_account_id_created_at;
+DROP TABLE IF EXISTS sessions;
+DROP TABLE IF EXISTS audit_records;
@@ -0,0 +1,38 @@
+-- Every change to an account's limits and exclusion. The limits in effect are
+-- derived from these records, so they are only ever added to.
+CREATE TABLE IF NOT EXISTS audit_records (
+	id INTEGER PRIMARY KEY,
+	account_id INTEGER NOT NULL REFERENCES accounts(id),
+	action TEXT NOT NULL,
+	limit_type TEXT NOT NULL DEFAULT '',
+	period TEXT NOT NULL DEFAULT '',
+	-- Previous and value are in cents, or minutes for SESSION limits.
+	previous INTEGER,
+	value INTEGER NOT NULL DEFAULT 0,
+	excluded_until DATETIME,
+	created_at DATETIME NOT NULL,
+	effective_at DATETIME NOT NULL
+);
+
+CREATE INDEX audit_records_account_id ON audit_records(account_id, id);
+
+CREATE TRIGGER audit_records_immutable_update BEFORE UPDATE ON audit_records
+BEGIN
+	SELECT RAISE(ABORT, 'audit records cannot be changed');
+END;
+
+CREATE TRIGGER audit_records_immutable_delete BEFORE DELETE ON audit_records
+BEGIN
+	SELECT RAISE(ABORT, 'audit records cannot be deleted');
+END;
+
+-- The current session of each account.
+CREATE TABLE IF NOT EXISTS sessions (
+	account_id INTEGER PRIMARY KEY REFERENCES accounts(id),
+	started_at DATETIME NOT NULL,
+	last_active_at DATETIME NOT NULL,
+	acknowledged_at DATETIME
+);
+
+-- Deposits and losses are summed over recent transactions.
+CREATE INDEX transactions_account_id_created_at ON transactions(account_id, created_at);
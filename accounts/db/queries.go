@@ -0,0 +1,130 @@
+package db
+
+const (
+	accountsCreate = "create_account"
+	accountsGet    = "get_account"
+
+	transactionsList      = "list_transactions"
+	transactionsCreate    = "create_transaction"
+	transactionsReference = "transaction_by_reference"
+
+	entriesCreate  = "create_entry"
+	entriesList    = "list_entries"
+	entriesBalance = "balance"
+
+	auditRecordsList   = "list_audit_records"
+	auditRecordsCreate = "create_audit_record"
+
+	sessionsGet         = "get_session"
+	sessionsStart       = "start_session"
+	sessionsTouch       = "touch_session"
+	sessionsAcknowledge = "acknowledge_session"
+
+	transactionsSince = "transactions_since"
+)
+
+func getAccountQueries() map[string]string {
+	return map[string]string{
+		accountsCreate: `
+			INSERT INTO accounts(name, created_at) 
+			VALUES (?,?)
+		`,
+		accountsGet: `
+			SELECT 
+				id, 
+				name, 
+				created_at 
+			FROM accounts
+			WHERE id = ?
+		`,
+		transactionsList: `
+			SELECT 
+				id, 
+				account_id, 
+				type, 
+				amount, 
+				reference, 
+				created_at 
+			FROM transactions
+		`,
+		// A transaction of the given types made with a reference, of which
+		// there is at most one.
+		transactionsReference: `
+			SELECT 
+				id, 
+				account_id, 
+				type, 
+				amount, 
+				reference, 
+				created_at 
+			FROM transactions
+			WHERE reference = ? AND type IN (?, ?)
+		`,
+		transactionsCreate: `
+			INSERT INTO transactions(account_id, type, amount, reference, created_at) 
+			VALUES (?,?,?,?,?)
+		`,
+		entriesCreate: `
+			INSERT INTO entries(transaction_id, ledger, amount) 
+			VALUES (?,?,?)
+		`,
+		entriesList: `
+			SELECT 
+				transaction_id, 
+				ledger, 
+				amount 
+			FROM entries
+		`,
+		// The balance of a ledger, which is the sum of its entries.
+		entriesBalance: `
+			SELECT COALESCE(SUM(amount), 0) 
+			FROM entries 
+			WHERE ledger = ?
+		`,
+		auditRecordsList: `
+			SELECT 
+				id, 
+				account_id, 
+				action, 
+				limit_type, 
+				period, 
+				previous, 
+				value, 
+				excluded_until, 
+				created_at, 
+				effective_at 
+			FROM audit_records
+		`,
+		auditRecordsCreate: `
+			INSERT INTO audit_records(account_id, action, limit_type, period, previous, value, excluded_until, created_at, effective_at) 
+			VALUES (?,?,?,?,?,?,?,?,?)
+		`,
+		sessionsGet: `
+			SELECT 
+				started_at, 
+				last_active_at, 
+				acknowledged_at 
+			FROM sessions
+			WHERE account_id = ?
+		`,
+		sessionsStart: `
+			INSERT OR REPLACE INTO sessions(account_id, started_at, last_active_at, acknowledged_at) 
+			VALUES (?,?,?,NULL)
+		`,
+		sessionsTouch: `
+			UPDATE sessions SET last_active_at = ? 
+			WHERE account_id = ?
+		`,
+		sessionsAcknowledge: `
+			UPDATE sessions SET acknowledged_at = ?, last_active_at = ? 
+			WHERE account_id = ?
+		`,
+		// The sum of an account's transactions of a type since, but not at, a
+		// time.
+		transactionsSince: `
+			SELECT COALESCE(SUM(amount), 0) 
+			FROM transactions 
+			WHERE account_id = ? AND type = ? AND created_at > ?
+		`,
+	}
+}
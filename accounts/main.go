@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"time"
+
+	"git.neds.sh/matty/entain/accounts/db"
+	"git.neds.sh/matty/entain/accounts/proto/accounts"
+	"git.neds.sh/matty/entain/accounts/service"
+	"google.golang.org/grpc"
+)
+
+var (
+	grpcEndpoint = flag.String("grpc-endpoint", "localhost:9003", "gRPC server endpoint")
+	dbPath       = flag.String("db", "./db/accounts.db", "Path of the SQLite accounts database")
+	coolingOff   = flag.Duration("cooling-off", 24*time.Hour, "How long raising or removing a limit takes to come into effect")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [migrate up|down|status]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+
+	if flag.Arg(0) == "migrate" {
+		if err := migrate(context.Background(), flag.Arg(1)); err != nil {
+			log.Fatalf("failed migrating database: %s\n", err)
+		}
+
+		return
+	}
+
+	if flag.NArg() > 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(); err != nil {
+		log.Fatalf("failed running grpc server: %s\n", err)
+	}
+}
+
+func run() error {
+	conn, err := net.Listen("tcp", *grpcEndpoint)
+	if err != nil {
+		return err
+	}
+
+	accountsDB, err := db.Open(*dbPath)
+	if err != nil {
+		return err
+	}
+
+	accountsRepo := db.NewAccountsRepo(accountsDB)
+	if err := accountsRepo.Init(context.Background()); err != nil {
+		return err
+	}
+
+	grpcServer := grpc.NewServer()
+
+	accounts.RegisterAccountsServer(
+		grpcServer,
+		service.NewAccountsService(accountsRepo, *coolingOff),
+	)
+
+	log.Printf("gRPC server listening on: %s\n", *grpcEndpoint)
+
+	if err := grpcServer.Serve(conn); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// migrate runs a migrate subcommand: "up" applies pending migrations, "down"
+// reverts the latest applied migration and "status" lists every migration.
+func migrate(ctx context.Context, command string) error {
+	accountsDB, err := db.Open(*dbPath)
+	if err != nil {
+		return err
+	}
+	defer accountsDB.Close()
+
+	migrator, err := db.NewMigrator(accountsDB)
+	if err != nil {
+		return err
+	}
+
+	switch command {
+	case "up":
+		migrated, err := migrator.Up(ctx)
+		for _, migration := range migrated {
+			log.Printf("applied migration %04d_%s\n", migration.Version, migration.Name)
+		}
+
+		if err == nil && len(migrated) == 0 {
+			log.Println("no pending migrations")
+		}
+
+		return err
+	case "down":
+		migration, err := migrator.Down(ctx)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("reverted migration %04d_%s\n", migration.Version, migration.Name)
+
+		return nil
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, status := range statuses {
+			applied := "pending"
+			if status.Applied {
+				applied = "applied " + status.AppliedAt.Format(time.RFC3339)
+			}
+
+			fmt.Printf("%04d_%s\t%s\n", status.Version, status.Name, applied)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command %q, expected up, down or status", command)
+	}
+}
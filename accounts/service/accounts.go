@@ -0,0 +1,299 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"git.neds.sh/matty/entain/accounts/db"
+	"git.neds.sh/matty/entain/accounts/proto/accounts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Accounts interface {
+	// CreateAccount will open a customer account.
+	CreateAccount(ctx context.Context, in *accounts.CreateAccountRequest) (*accounts.Account, error)
+
+	// GetBalance will return the balance of an account.
+	GetBalance(ctx context.Context, in *accounts.GetBalanceRequest) (*accounts.Balance, error)
+
+	// ListTransactions will return a collection of an account's transactions.
+	ListTransactions(ctx context.Context, in *accounts.ListTransactionsRequest) (*accounts.ListTransactionsResponse, error)
+
+	// Deposit will credit an account with money paid in.
+	Deposit(ctx context.Context, in *accounts.DepositRequest) (*accounts.Transaction, error)
+
+	// Withdraw will debit an account with money paid out.
+	Withdraw(ctx context.Context, in *accounts.WithdrawRequest) (*accounts.Transaction, error)
+
+	// ReserveStake will hold the stake of a bet out of an account's balance.
+	ReserveStake(ctx context.Context, in *accounts.ReserveStakeRequest) (*accounts.Transaction, error)
+
+	// PayoutStake will settle the stake held for a bet that has won or lost.
+	PayoutStake(ctx context.Context, in *accounts.PayoutStakeRequest) (*accounts.Transaction, error)
+
+	// RefundStake will return the stake held for a bet.
+	RefundStake(ctx context.Context, in *accounts.RefundStakeRequest) (*accounts.Transaction, error)
+
+	// GetLimits will return the limits and exclusion of an account.
+	GetLimits(ctx context.Context, in *accounts.GetLimitsRequest) (*accounts.Limits, error)
+
+	// SetLimit will set, change or remove a limit on an account.
+	SetLimit(ctx context.Context, in *accounts.SetLimitRequest) (*accounts.AuditRecord, error)
+
+	// SelfExclude will exclude an account from depositing and betting.
+	SelfExclude(ctx context.Context, in *accounts.SelfExcludeRequest) (*accounts.AuditRecord, error)
+
+	// ListAuditRecords will return a collection of an account's audit records.
+	ListAuditRecords(ctx context.Context, in *accounts.ListAuditRecordsRequest) (*accounts.ListAuditRecordsResponse, error)
+
+	// GetSession will return an account's current session.
+	GetSession(ctx context.Context, in *accounts.GetSessionRequest) (*accounts.Session, error)
+
+	// AcknowledgeReminder will acknowledge an account's session reminder.
+	AcknowledgeReminder(ctx context.Context, in *accounts.AcknowledgeReminderRequest) (*accounts.Session, error)
+}
+
+// accountsService implements the Accounts interface.
+type accountsService struct {
+	accountsRepo db.AccountsRepo
+	// coolingOff is how long raising or removing a limit takes to come into
+	// effect.
+	coolingOff time.Duration
+}
+
+// NewAccountsService instantiates and returns a new accountsService.
+func NewAccountsService(accountsRepo db.AccountsRepo, coolingOff time.Duration) Accounts {
+	return &accountsService{accountsRepo, coolingOff}
+}
+
+func (s *accountsService) CreateAccount(ctx context.Context, in *accounts.CreateAccountRequest) (*accounts.Account, error) {
+	if err := validateCreateAccountRequest(in); err != nil {
+		return nil, err
+	}
+
+	account, err := s.accountsRepo.CreateAccount(ctx, strings.TrimSpace(in.Name))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return account, nil
+}
+
+func (s *accountsService) GetBalance(ctx context.Context, in *accounts.GetBalanceRequest) (*accounts.Balance, error) {
+	if in.AccountId <= 0 {
+		return nil, invalidField("account_id", "must be positive")
+	}
+
+	balance, err := s.accountsRepo.GetBalance(ctx, in.AccountId)
+	if err != nil {
+		return nil, ledgerError(err, in.AccountId)
+	}
+
+	return balance, nil
+}
+
+func (s *accountsService) ListTransactions(ctx context.Context, in *accounts.ListTransactionsRequest) (*accounts.ListTransactionsResponse, error) {
+	if err := validateListTransactionsRequest(in); err != nil {
+		return nil, err
+	}
+
+	transactions, next, err := s.accountsRepo.ListTransactions(ctx, in)
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidPageToken) {
+			return nil, invalidField("page_token", describe(err, db.ErrInvalidPageToken))
+		}
+
+		return nil, ledgerError(err, in.AccountId)
+	}
+
+	return &accounts.ListTransactionsResponse{Transactions: transactions, NextPageToken: next}, nil
+}
+
+func (s *accountsService) Deposit(ctx context.Context, in *accounts.DepositRequest) (*accounts.Transaction, error) {
+	if err := validateTransfer(in.AccountId, in.Amount, in.Reference); err != nil {
+		return nil, err
+	}
+
+	txn, err := s.accountsRepo.Deposit(ctx, in.AccountId, in.Amount, in.Reference)
+	if err != nil {
+		return nil, ledgerError(err, in.AccountId)
+	}
+
+	return txn, nil
+}
+
+func (s *accountsService) Withdraw(ctx context.Context, in *accounts.WithdrawRequest) (*accounts.Transaction, error) {
+	if err := validateTransfer(in.AccountId, in.Amount, in.Reference); err != nil {
+		return nil, err
+	}
+
+	txn, err := s.accountsRepo.Withdraw(ctx, in.AccountId, in.Amount, in.Reference)
+	if err != nil {
+		return nil, ledgerError(err, in.AccountId)
+	}
+
+	return txn, nil
+}
+
+func (s *accountsService) ReserveStake(ctx context.Context, in *accounts.ReserveStakeRequest) (*accounts.Transaction, error) {
+	if err := validateTransfer(in.AccountId, in.Amount, in.Reference); err != nil {
+		return nil, err
+	}
+
+	txn, err := s.accountsRepo.ReserveStake(ctx, in.AccountId, in.Amount, in.Reference)
+	if err != nil {
+		return nil, ledgerError(err, in.AccountId)
+	}
+
+	return txn, nil
+}
+
+func (s *accountsService) PayoutStake(ctx context.Context, in *accounts.PayoutStakeRequest) (*accounts.Transaction, error) {
+	if err := validatePayoutStakeRequest(in); err != nil {
+		return nil, err
+	}
+
+	txn, err := s.accountsRepo.PayoutStake(ctx, in.Reference, in.Amount)
+	if err != nil {
+		return nil, ledgerError(err, 0)
+	}
+
+	return txn, nil
+}
+
+func (s *accountsService) RefundStake(ctx context.Context, in *accounts.RefundStakeRequest) (*accounts.Transaction, error) {
+	var v violations
+	validateReference(in.Reference, &v)
+
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	txn, err := s.accountsRepo.RefundStake(ctx, in.Reference)
+	if err != nil {
+		return nil, ledgerError(err, 0)
+	}
+
+	return txn, nil
+}
+
+func (s *accountsService) GetLimits(ctx context.Context, in *accounts.GetLimitsRequest) (*accounts.Limits, error) {
+	if in.AccountId <= 0 {
+		return nil, invalidField("account_id", "must be positive")
+	}
+
+	limits, err := s.accountsRepo.GetLimits(ctx, in.AccountId)
+	if err != nil {
+		return nil, ledgerError(err, in.AccountId)
+	}
+
+	return limits, nil
+}
+
+func (s *accountsService) SetLimit(ctx context.Context, in *accounts.SetLimitRequest) (*accounts.AuditRecord, error) {
+	if err := validateSetLimitRequest(in); err != nil {
+		return nil, err
+	}
+
+	record, err := s.accountsRepo.SetLimit(ctx, in.AccountId, in.Limit, s.coolingOff)
+	if err != nil {
+		return nil, ledgerError(err, in.AccountId)
+	}
+
+	return record, nil
+}
+
+func (s *accountsService) SelfExclude(ctx context.Context, in *accounts.SelfExcludeRequest) (*accounts.AuditRecord, error) {
+	if err := validateSelfExcludeRequest(in); err != nil {
+		return nil, err
+	}
+
+	record, err := s.accountsRepo.SelfExclude(ctx, in.AccountId, time.Duration(in.Days)*24*time.Hour)
+	if err != nil {
+		return nil, ledgerError(err, in.AccountId)
+	}
+
+	return record, nil
+}
+
+func (s *accountsService) ListAuditRecords(ctx context.Context, in *accounts.ListAuditRecordsRequest) (*accounts.ListAuditRecordsResponse, error) {
+	if err := validateListAuditRecordsRequest(in); err != nil {
+		return nil, err
+	}
+
+	records, next, err := s.accountsRepo.ListAuditRecords(ctx, in)
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidPageToken) {
+			return nil, invalidField("page_token", describe(err, db.ErrInvalidPageToken))
+		}
+
+		return nil, ledgerError(err, in.AccountId)
+	}
+
+	return &accounts.ListAuditRecordsResponse{AuditRecords: records, NextPageToken: next}, nil
+}
+
+func (s *accountsService) GetSession(ctx context.Context, in *accounts.GetSessionRequest) (*accounts.Session, error) {
+	if in.AccountId <= 0 {
+		return nil, invalidField("account_id", "must be positive")
+	}
+
+	current, err := s.accountsRepo.GetSession(ctx, in.AccountId)
+	if err != nil {
+		return nil, ledgerError(err, in.AccountId)
+	}
+
+	return current, nil
+}
+
+func (s *accountsService) AcknowledgeReminder(ctx context.Context, in *accounts.AcknowledgeReminderRequest) (*accounts.Session, error) {
+	if in.AccountId <= 0 {
+		return nil, invalidField("account_id", "must be positive")
+	}
+
+	current, err := s.accountsRepo.AcknowledgeReminder(ctx, in.AccountId)
+	if err != nil {
+		return nil, ledgerError(err, in.AccountId)
+	}
+
+	return current, nil
+}
+
+// ledgerError converts an error from the ledger into a gRPC status, reporting
+// a missing account by the given ID.
+func ledgerError(err error, accountID int64) error {
+	switch {
+	case errors.Is(err, db.ErrAccountNotFound):
+		return status.Errorf(codes.NotFound, "account %d not found", accountID)
+	case errors.Is(err, db.ErrStakeNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, db.ErrInsufficientFunds), errors.Is(err, db.ErrStakeSettled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, db.ErrSelfExcluded),
+		errors.Is(err, db.ErrLimitExceeded),
+		errors.Is(err, db.ErrReminderDue),
+		errors.Is(err, db.ErrExclusionShortened):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, db.ErrReferenceReused):
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	return toStatus(err)
+}
+
+// toStatus converts context errors surfaced by the repository, such as a
+// cancelled request, into their gRPC status equivalents. Other errors are
+// returned unchanged.
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	}
+
+	return err
+}
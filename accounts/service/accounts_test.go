@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"git.neds.sh/matty/entain/accounts/db"
+)
+
+func TestLedgerError(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		err         error
+		wantCode    codes.Code
+		wantMessage string
+	}{
+		{"account not found", db.ErrAccountNotFound, codes.NotFound, "account 7 not found"},
+		{"stake not found", fmt.Errorf("%w: bet-a", db.ErrStakeNotFound), codes.NotFound, "stake not found: bet-a"},
+		{"insufficient funds", db.ErrInsufficientFunds, codes.FailedPrecondition, "insufficient funds"},
+		{"stake settled", db.ErrStakeSettled, codes.FailedPrecondition, "stake already settled"},
+		{"self-excluded", fmt.Errorf("%w until 2021-03-08", db.ErrSelfExcluded), codes.FailedPrecondition, "account is self-excluded until 2021-03-08"},
+		{"limit exceeded", fmt.Errorf("%w: the daily deposit limit is 100 cents", db.ErrLimitExceeded), codes.FailedPrecondition, "limit exceeded: the daily deposit limit is 100 cents"},
+		{"reminder due", db.ErrReminderDue, codes.FailedPrecondition, "session reminder due"},
+		{"exclusion shortened", db.ErrExclusionShortened, codes.FailedPrecondition, "exclusion cannot be shortened"},
+		{"reference reused", fmt.Errorf("%w: dep-1", db.ErrReferenceReused), codes.AlreadyExists, "reference already used: dep-1"},
+		{"deadline exceeded", context.DeadlineExceeded, codes.DeadlineExceeded, "context deadline exceeded"},
+		{"cancelled", context.Canceled, codes.Canceled, "context canceled"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			st := status.Convert(ledgerError(test.err, 7))
+
+			if st.Code() != test.wantCode || st.Message() != test.wantMessage {
+				t.Errorf("got %s %q, want %s %q", st.Code(), st.Message(), test.wantCode, test.wantMessage)
+			}
+		})
+	}
+
+	// Other errors are passed through unchanged.
+	other := errors.New("disk on fire")
+	if err := ledgerError(other, 7); err != other {
+		t.Errorf("got %v, want the error unchanged", err)
+	}
+}
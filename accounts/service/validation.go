@@ -0,0 +1,213 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"git.neds.sh/matty/entain/accounts/proto/accounts"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxAmount caps the amount of a single transaction, in cents.
+	maxAmount = 100000000
+
+	// maxNameLength caps the length of a customer's name.
+	maxNameLength = 100
+
+	// maxReferenceLength caps the length of a transaction's reference.
+	maxReferenceLength = 64
+
+	// maxSessionMinutes caps the interval between session reminders.
+	maxSessionMinutes = 24 * 60
+
+	// maxExclusionDays caps the length of a self-exclusion, at five years.
+	maxExclusionDays = 5 * 365
+)
+
+// violations collects the fields of a request that failed validation.
+type violations []*errdetails.BadRequest_FieldViolation
+
+// add records a field that failed validation.
+func (v *violations) add(field, description string) {
+	*v = append(*v, &errdetails.BadRequest_FieldViolation{Field: field, Description: description})
+}
+
+// err returns an InvalidArgument error carrying a BadRequest detail listing
+// every violation, or nil when there are none.
+func (v violations) err() error {
+	if len(v) == 0 {
+		return nil
+	}
+
+	message := fmt.Sprintf("invalid %s: %s", v[0].Field, v[0].Description)
+	if len(v) > 1 {
+		message += fmt.Sprintf(" (and %d more)", len(v)-1)
+	}
+
+	st, err := status.New(codes.InvalidArgument, message).WithDetails(&errdetails.BadRequest{FieldViolations: v})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, message)
+	}
+
+	return st.Err()
+}
+
+// invalidField returns an InvalidArgument error for a single field.
+func invalidField(field, description string) error {
+	var v violations
+	v.add(field, description)
+
+	return v.err()
+}
+
+// describe returns the description of an error wrapping a sentinel, without
+// the sentinel's own message repeating the field name.
+func describe(err, sentinel error) string {
+	return strings.TrimPrefix(err.Error(), sentinel.Error()+": ")
+}
+
+// validateCreateAccountRequest checks a CreateAccountRequest is well formed.
+func validateCreateAccountRequest(in *accounts.CreateAccountRequest) error {
+	if name := strings.TrimSpace(in.Name); name == "" || len(name) > maxNameLength {
+		return invalidField("name", fmt.Sprintf("must be set and at most %d characters", maxNameLength))
+	}
+
+	return nil
+}
+
+// validateListTransactionsRequest checks a ListTransactionsRequest is well
+// formed. The page token is checked when it is decoded.
+func validateListTransactionsRequest(in *accounts.ListTransactionsRequest) error {
+	var v violations
+
+	validateAccountID(in.AccountId, &v)
+
+	if in.PageSize < 0 {
+		v.add("page_size", "must not be negative")
+	}
+
+	return v.err()
+}
+
+// validateListAuditRecordsRequest checks a ListAuditRecordsRequest is well
+// formed. The page token is checked when it is decoded.
+func validateListAuditRecordsRequest(in *accounts.ListAuditRecordsRequest) error {
+	var v violations
+
+	validateAccountID(in.AccountId, &v)
+
+	if in.PageSize < 0 {
+		v.add("page_size", "must not be negative")
+	}
+
+	return v.err()
+}
+
+// validateSetLimitRequest checks a SetLimitRequest is well formed. Deposit
+// and loss limits are amounts over a period, and session limits are minutes.
+// A value of zero removes the limit.
+func validateSetLimitRequest(in *accounts.SetLimitRequest) error {
+	var v violations
+
+	validateAccountID(in.AccountId, &v)
+
+	limit := in.Limit
+	if limit == nil {
+		v.add("limit", "must be set")
+
+		return v.err()
+	}
+
+	switch limit.Type {
+	case accounts.Limit_DEPOSIT, accounts.Limit_LOSS:
+		if _, ok := accounts.Limit_Period_name[int32(limit.Period)]; !ok {
+			v.add("limit.period", fmt.Sprintf("unknown period %d", limit.Period))
+		} else if limit.Period == accounts.Limit_PERIOD_UNSPECIFIED {
+			v.add("limit.period", "must be set for deposit and loss limits")
+		}
+
+		if limit.Amount < 0 || limit.Amount > maxAmount {
+			v.add("limit.amount", fmt.Sprintf("must be between 0 and %d cents", maxAmount))
+		}
+
+		if limit.Minutes != 0 {
+			v.add("limit.minutes", "must only be set for session limits")
+		}
+	case accounts.Limit_SESSION:
+		if limit.Period != accounts.Limit_PERIOD_UNSPECIFIED {
+			v.add("limit.period", "must not be set for session limits")
+		}
+
+		if limit.Amount != 0 {
+			v.add("limit.amount", "must not be set for session limits")
+		}
+
+		if limit.Minutes < 0 || limit.Minutes > maxSessionMinutes {
+			v.add("limit.minutes", fmt.Sprintf("must be between 0 and %d", maxSessionMinutes))
+		}
+	default:
+		v.add("limit.type", "must be DEPOSIT, LOSS or SESSION")
+	}
+
+	return v.err()
+}
+
+// validateSelfExcludeRequest checks a SelfExcludeRequest is well formed.
+func validateSelfExcludeRequest(in *accounts.SelfExcludeRequest) error {
+	var v violations
+
+	validateAccountID(in.AccountId, &v)
+
+	if in.Days <= 0 || in.Days > maxExclusionDays {
+		v.add("days", fmt.Sprintf("must be between 1 and %d", maxExclusionDays))
+	}
+
+	return v.err()
+}
+
+// validateTransfer checks the fields of a request moving money into or out
+// of an account are well formed.
+func validateTransfer(accountID, amount int64, reference string) error {
+	var v violations
+
+	validateAccountID(accountID, &v)
+	validateAmount(amount, &v)
+	validateReference(reference, &v)
+
+	return v.err()
+}
+
+// validatePayoutStakeRequest checks a PayoutStakeRequest is well formed. The
+// amount of a bet that lost is zero.
+func validatePayoutStakeRequest(in *accounts.PayoutStakeRequest) error {
+	var v violations
+
+	validateReference(in.Reference, &v)
+
+	if in.Amount < 0 {
+		v.add("amount", "must not be negative")
+	}
+
+	return v.err()
+}
+
+func validateAccountID(accountID int64, v *violations) {
+	if accountID <= 0 {
+		v.add("account_id", "must be positive")
+	}
+}
+
+func validateAmount(amount int64, v *violations) {
+	if amount <= 0 || amount > maxAmount {
+		v.add("amount", fmt.Sprintf("must be positive and at most %d cents", maxAmount))
+	}
+}
+
+func validateReference(reference string, v *violations) {
+	if reference == "" || len(reference) > maxReferenceLength {
+		v.add("reference", fmt.Sprintf("must be set and at most %d characters", maxReferenceLength))
+	}
+}
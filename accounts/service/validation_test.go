@@ -0,0 +1,166 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"git.neds.sh/matty/entain/accounts/proto/accounts"
+)
+
+// fieldViolations returns the field violations carried by an error's
+// BadRequest detail, as "field: description" strings.
+func fieldViolations(t *testing.T, err error) []string {
+	t.Helper()
+
+	var got []string
+
+	for _, detail := range status.Convert(err).Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+
+		for _, violation := range badRequest.FieldViolations {
+			got = append(got, violation.Field+": "+violation.Description)
+		}
+	}
+
+	return got
+}
+
+// checkViolations checks a validation error carries exactly the violations
+// wanted, or that there is no error when none are.
+func checkViolations(t *testing.T, err error, want []string) {
+	t.Helper()
+
+	if len(want) == 0 {
+		if err != nil {
+			t.Fatalf("got error %v, want none", err)
+		}
+
+		return
+	}
+
+	if code := status.Code(err); code != codes.InvalidArgument {
+		t.Fatalf("got code %s (%v), want %s", code, err, codes.InvalidArgument)
+	}
+
+	got := fieldViolations(t, err)
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got violations %q, want %q", got, want)
+	}
+}
+
+func TestValidateSetLimitRequest(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		in   *accounts.SetLimitRequest
+		want []string
+	}{
+		{
+			name: "deposit limit",
+			in:   &accounts.SetLimitRequest{AccountId: 1, Limit: &accounts.Limit{Type: accounts.Limit_DEPOSIT, Period: accounts.Limit_WEEK, Amount: 50000}},
+		},
+		{
+			name: "loss limit removed",
+			in:   &accounts.SetLimitRequest{AccountId: 1, Limit: &accounts.Limit{Type: accounts.Limit_LOSS, Period: accounts.Limit_DAY}},
+		},
+		{
+			name: "session limit",
+			in:   &accounts.SetLimitRequest{AccountId: 1, Limit: &accounts.Limit{Type: accounts.Limit_SESSION, Minutes: 60}},
+		},
+		{
+			name: "no account",
+			in:   &accounts.SetLimitRequest{Limit: &accounts.Limit{Type: accounts.Limit_DEPOSIT, Period: accounts.Limit_DAY, Amount: 100}},
+			want: []string{"account_id: must be positive"},
+		},
+		{
+			name: "no limit",
+			in:   &accounts.SetLimitRequest{AccountId: 1},
+			want: []string{"limit: must be set"},
+		},
+		{
+			name: "unknown type",
+			in:   &accounts.SetLimitRequest{AccountId: 1, Limit: &accounts.Limit{Type: 9}},
+			want: []string{"limit.type: must be DEPOSIT, LOSS or SESSION"},
+		},
+		{
+			name: "deposit limit without a period",
+			in:   &accounts.SetLimitRequest{AccountId: 1, Limit: &accounts.Limit{Type: accounts.Limit_DEPOSIT, Amount: 100}},
+			want: []string{"limit.period: must be set for deposit and loss limits"},
+		},
+		{
+			name: "unknown period",
+			in:   &accounts.SetLimitRequest{AccountId: 1, Limit: &accounts.Limit{Type: accounts.Limit_LOSS, Period: 9, Amount: 100}},
+			want: []string{"limit.period: unknown period 9"},
+		},
+		{
+			name: "deposit limit too large",
+			in:   &accounts.SetLimitRequest{AccountId: 1, Limit: &accounts.Limit{Type: accounts.Limit_DEPOSIT, Period: accounts.Limit_MONTH, Amount: maxAmount + 1, Minutes: 5}},
+			want: []string{
+				fmt.Sprintf("limit.amount: must be between 0 and %d cents", maxAmount),
+				"limit.minutes: must only be set for session limits",
+			},
+		},
+		{
+			name: "session limit with an amount over a period",
+			in:   &accounts.SetLimitRequest{AccountId: 1, Limit: &accounts.Limit{Type: accounts.Limit_SESSION, Period: accounts.Limit_DAY, Amount: 100, Minutes: 60}},
+			want: []string{
+				"limit.period: must not be set for session limits",
+				"limit.amount: must not be set for session limits",
+			},
+		},
+		{
+			name: "session limit too long",
+			in:   &accounts.SetLimitRequest{AccountId: 1, Limit: &accounts.Limit{Type: accounts.Limit_SESSION, Minutes: maxSessionMinutes + 1}},
+			want: []string{fmt.Sprintf("limit.minutes: must be between 0 and %d", maxSessionMinutes)},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			checkViolations(t, validateSetLimitRequest(test.in), test.want)
+		})
+	}
+}
+
+func TestValidateSelfExcludeRequest(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		in   *accounts.SelfExcludeRequest
+		want []string
+	}{
+		{
+			name: "a day",
+			in:   &accounts.SelfExcludeRequest{AccountId: 1, Days: 1},
+		},
+		{
+			name: "the longest exclusion",
+			in:   &accounts.SelfExcludeRequest{AccountId: 1, Days: maxExclusionDays},
+		},
+		{
+			name: "no days",
+			in:   &accounts.SelfExcludeRequest{AccountId: 1},
+			want: []string{fmt.Sprintf("days: must be between 1 and %d", maxExclusionDays)},
+		},
+		{
+			name: "too many days",
+			in:   &accounts.SelfExcludeRequest{AccountId: 1, Days: maxExclusionDays + 1},
+			want: []string{fmt.Sprintf("days: must be between 1 and %d", maxExclusionDays)},
+		},
+		{
+			name: "several violations",
+			in:   &accounts.SelfExcludeRequest{AccountId: -1, Days: -1},
+			want: []string{
+				"account_id: must be positive",
+				fmt.Sprintf("days: must be between 1 and %d", maxExclusionDays),
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			checkViolations(t, validateSelfExcludeRequest(test.in), test.want)
+		})
+	}
+}
@@ -5,15 +5,28 @@ import (
 	"flag"
 	"log"
 	"net/http"
+	"time"
 
+	"git.neds.sh/matty/entain/api/proto/accounts"
+	"git.neds.sh/matty/entain/api/proto/betting"
 	"git.neds.sh/matty/entain/api/proto/racing"
+	"git.neds.sh/matty/entain/api/proto/sports"
+	"git.neds.sh/matty/entain/api/sse"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
+
+	// Registers the error detail types returned by the services, so the
+	// gateway can render them in error responses.
+	_ "google.golang.org/genproto/googleapis/rpc/errdetails"
 )
 
 var (
-	apiEndpoint  = flag.String("api-endpoint", "localhost:8000", "API endpoint")
-	grpcEndpoint = flag.String("grpc-endpoint", "localhost:9000", "gRPC server endpoint")
+	apiEndpoint          = flag.String("api-endpoint", "localhost:8000", "API endpoint")
+	grpcEndpoint         = flag.String("grpc-endpoint", "localhost:9000", "gRPC server endpoint")
+	sportsGrpcEndpoint   = flag.String("sports-grpc-endpoint", "localhost:9001", "Sports gRPC server endpoint")
+	bettingGrpcEndpoint  = flag.String("betting-grpc-endpoint", "localhost:9002", "Betting gRPC server endpoint")
+	accountsGrpcEndpoint = flag.String("accounts-grpc-endpoint", "localhost:9003", "Accounts gRPC server endpoint")
+	sseHeartbeat         = flag.Duration("sse-heartbeat", 15*time.Second, "Interval between server-sent event heartbeats")
 )
 
 func main() {
@@ -39,6 +52,61 @@ func run() error {
 		return err
 	}
 
+	if err := racing.RegisterPricesHandlerFromEndpoint(
+		ctx,
+		mux,
+		*grpcEndpoint,
+		[]grpc.DialOption{grpc.WithInsecure()},
+	); err != nil {
+		return err
+	}
+
+	if err := sports.RegisterSportsHandlerFromEndpoint(
+		ctx,
+		mux,
+		*sportsGrpcEndpoint,
+		[]grpc.DialOption{grpc.WithInsecure()},
+	); err != nil {
+		return err
+	}
+
+	if err := betting.RegisterBettingHandlerFromEndpoint(
+		ctx,
+		mux,
+		*bettingGrpcEndpoint,
+		[]grpc.DialOption{grpc.WithInsecure()},
+	); err != nil {
+		return err
+	}
+
+	if err := accounts.RegisterAccountsHandlerFromEndpoint(
+		ctx,
+		mux,
+		*accountsGrpcEndpoint,
+		[]grpc.DialOption{grpc.WithInsecure()},
+	); err != nil {
+		return err
+	}
+
+	// Race changes and price fluctuations are also served as server-sent
+	// events for browsers, which cannot consume the WatchRaces and WatchPrices
+	// gRPC streams directly.
+	racingConn, err := grpc.DialContext(ctx, *grpcEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer racingConn.Close()
+
+	raceWatcher := sse.NewRaceWatcher(mux, racing.NewRacingClient(racingConn), *sseHeartbeat)
+	if err := mux.HandlePath(http.MethodGet, "/v1/races:watch", raceWatcher.Handle); err != nil {
+		return err
+	}
+
+	priceWatcher := sse.NewPriceWatcher(mux, racing.NewPricesClient(racingConn), *sseHeartbeat)
+	if err := mux.HandlePath(http.MethodGet, "/v1/races/{race_id}/prices:watch", priceWatcher.Handle); err != nil {
+		return err
+	}
+
 	log.Printf("API server listening on: %s\n", *apiEndpoint)
 
 	return http.ListenAndServe(*apiEndpoint, mux)
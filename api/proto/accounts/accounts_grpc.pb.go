@@ -0,0 +1,627 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package accounts
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AccountsClient is the client API for Accounts service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AccountsClient interface {
+	// CreateAccount opens a customer account with a zero balance.
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	// GetBalance returns the balance of an account.
+	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*Balance, error)
+	// ListTransactions returns the transactions of an account, newest
+	// first.
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	// Deposit credits an account with money paid in.
+	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*Transaction, error)
+	// Withdraw debits an account with money paid out, should its balance
+	// cover it.
+	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*Transaction, error)
+	// GetLimits returns the responsible gambling limits in effect on an
+	// account, those waiting out their cooling-off period, and any
+	// self-exclusion.
+	GetLimits(ctx context.Context, in *GetLimitsRequest, opts ...grpc.CallOption) (*Limits, error)
+	// SetLimit sets a limit on an account, returning the audit record of
+	// the change. A limit of zero removes it. Lowering or setting a limit takes
+	// effect at once, while raising or removing one takes effect after a
+	// cooling-off period, unless a later change supersedes it first.
+	SetLimit(ctx context.Context, in *SetLimitRequest, opts ...grpc.CallOption) (*AuditRecord, error)
+	// SelfExclude excludes an account from depositing and betting for a
+	// number of days, returning the audit record of the exclusion. An
+	// exclusion can be extended, but not shortened.
+	SelfExclude(ctx context.Context, in *SelfExcludeRequest, opts ...grpc.CallOption) (*AuditRecord, error)
+	// ListAuditRecords returns the record of every change to an account's
+	// limits and exclusions, newest first.
+	ListAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...grpc.CallOption) (*ListAuditRecordsResponse, error)
+	// GetSession returns an account's current session.
+	GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*Session, error)
+	// AcknowledgeReminder acknowledges a session reminder that is due,
+	// allowing the customer to carry on depositing and betting.
+	AcknowledgeReminder(ctx context.Context, in *AcknowledgeReminderRequest, opts ...grpc.CallOption) (*Session, error)
+	// ReserveStake holds the stake of a bet out of an account's balance,
+	// should it cover the stake, until the bet is paid out or refunded. The
+	// balance is checked and the stake held atomically.
+	ReserveStake(ctx context.Context, in *ReserveStakeRequest, opts ...grpc.CallOption) (*Transaction, error)
+	// PayoutStake settles the stake held for a bet that has won or lost,
+	// crediting the account with the payout, if any.
+	PayoutStake(ctx context.Context, in *PayoutStakeRequest, opts ...grpc.CallOption) (*Transaction, error)
+	// RefundStake returns the stake held for a bet to the account, as when
+	// the bet is refunded or could not be accepted.
+	RefundStake(ctx context.Context, in *RefundStakeRequest, opts ...grpc.CallOption) (*Transaction, error)
+}
+
+type accountsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccountsClient(cc grpc.ClientConnInterface) AccountsClient {
+	return &accountsClient{cc}
+}
+
+func (c *accountsClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/accounts.Accounts/CreateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*Balance, error) {
+	out := new(Balance)
+	err := c.cc.Invoke(ctx, "/accounts.Accounts/GetBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/accounts.Accounts/ListTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, "/accounts.Accounts/Deposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, "/accounts.Accounts/Withdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) GetLimits(ctx context.Context, in *GetLimitsRequest, opts ...grpc.CallOption) (*Limits, error) {
+	out := new(Limits)
+	err := c.cc.Invoke(ctx, "/accounts.Accounts/GetLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) SetLimit(ctx context.Context, in *SetLimitRequest, opts ...grpc.CallOption) (*AuditRecord, error) {
+	out := new(AuditRecord)
+	err := c.cc.Invoke(ctx, "/accounts.Accounts/SetLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) SelfExclude(ctx context.Context, in *SelfExcludeRequest, opts ...grpc.CallOption) (*AuditRecord, error) {
+	out := new(AuditRecord)
+	err := c.cc.Invoke(ctx, "/accounts.Accounts/SelfExclude", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) ListAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...grpc.CallOption) (*ListAuditRecordsResponse, error) {
+	out := new(ListAuditRecordsResponse)
+	err := c.cc.Invoke(ctx, "/accounts.Accounts/ListAuditRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*Session, error) {
+	out := new(Session)
+	err := c.cc.Invoke(ctx, "/accounts.Accounts/GetSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) AcknowledgeReminder(ctx context.Context, in *AcknowledgeReminderRequest, opts ...grpc.CallOption) (*Session, error) {
+	out := new(Session)
+	err := c.cc.Invoke(ctx, "/accounts.Accounts/AcknowledgeReminder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) ReserveStake(ctx context.Context, in *ReserveStakeRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, "/accounts.Accounts/ReserveStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) PayoutStake(ctx context.Context, in *PayoutStakeRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, "/accounts.Accounts/PayoutStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) RefundStake(ctx context.Context, in *RefundStakeRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, "/accounts.Accounts/RefundStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccountsServer is the server API for Accounts service.
+// All implementations must embed UnimplementedAccountsServer
+// for forward compatibility
+type AccountsServer interface {
+	// CreateAccount opens a customer account with a zero balance.
+	CreateAccount(context.Context, *CreateAccountRequest) (*Account, error)
+	// GetBalance returns the balance of an account.
+	GetBalance(context.Context, *GetBalanceRequest) (*Balance, error)
+	// ListTransactions returns the transactions of an account, newest
+	// first.
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	// Deposit credits an account with money paid in.
+	Deposit(context.Context, *DepositRequest) (*Transaction, error)
+	// Withdraw debits an account with money paid out, should its balance
+	// cover it.
+	Withdraw(context.Context, *WithdrawRequest) (*Transaction, error)
+	// GetLimits returns the responsible gambling limits in effect on an
+	// account, those waiting out their cooling-off period, and any
+	// self-exclusion.
+	GetLimits(context.Context, *GetLimitsRequest) (*Limits, error)
+	// SetLimit sets a limit on an account, returning the audit record of
+	// the change. A limit of zero removes it. Lowering or setting a limit takes
+	// effect at once, while raising or removing one takes effect after a
+	// cooling-off period, unless a later change supersedes it first.
+	SetLimit(context.Context, *SetLimitRequest) (*AuditRecord, error)
+	// SelfExclude excludes an account from depositing and betting for a
+	// number of days, returning the audit record of the exclusion. An
+	// exclusion can be extended, but not shortened.
+	SelfExclude(context.Context, *SelfExcludeRequest) (*AuditRecord, error)
+	// ListAuditRecords returns the record of every change to an account's
+	// limits and exclusions, newest first.
+	ListAuditRecords(context.Context, *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error)
+	// GetSession returns an account's current session.
+	GetSession(context.Context, *GetSessionRequest) (*Session, error)
+	// AcknowledgeReminder acknowledges a session reminder that is due,
+	// allowing the customer to carry on depositing and betting.
+	AcknowledgeReminder(context.Context, *AcknowledgeReminderRequest) (*Session, error)
+	// ReserveStake holds the stake of a bet out of an account's balance,
+	// should it cover the stake, until the bet is paid out or refunded. The
+	// balance is checked and the stake held atomically.
+	ReserveStake(context.Context, *ReserveStakeRequest) (*Transaction, error)
+	// PayoutStake settles the stake held for a bet that has won or lost,
+	// crediting the account with the payout, if any.
+	PayoutStake(context.Context, *PayoutStakeRequest) (*Transaction, error)
+	// RefundStake returns the stake held for a bet to the account, as when
+	// the bet is refunded or could not be accepted.
+	RefundStake(context.Context, *RefundStakeRequest) (*Transaction, error)
+	mustEmbedUnimplementedAccountsServer()
+}
+
+// UnimplementedAccountsServer must be embedded to have forward compatible implementations.
+type UnimplementedAccountsServer struct {
+}
+
+func (UnimplementedAccountsServer) CreateAccount(context.Context, *CreateAccountRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedAccountsServer) GetBalance(context.Context, *GetBalanceRequest) (*Balance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedAccountsServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedAccountsServer) Deposit(context.Context, *DepositRequest) (*Transaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (UnimplementedAccountsServer) Withdraw(context.Context, *WithdrawRequest) (*Transaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (UnimplementedAccountsServer) GetLimits(context.Context, *GetLimitsRequest) (*Limits, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLimits not implemented")
+}
+func (UnimplementedAccountsServer) SetLimit(context.Context, *SetLimitRequest) (*AuditRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLimit not implemented")
+}
+func (UnimplementedAccountsServer) SelfExclude(context.Context, *SelfExcludeRequest) (*AuditRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelfExclude not implemented")
+}
+func (UnimplementedAccountsServer) ListAuditRecords(context.Context, *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditRecords not implemented")
+}
+func (UnimplementedAccountsServer) GetSession(context.Context, *GetSessionRequest) (*Session, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSession not implemented")
+}
+func (UnimplementedAccountsServer) AcknowledgeReminder(context.Context, *AcknowledgeReminderRequest) (*Session, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeReminder not implemented")
+}
+func (UnimplementedAccountsServer) ReserveStake(context.Context, *ReserveStakeRequest) (*Transaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveStake not implemented")
+}
+func (UnimplementedAccountsServer) PayoutStake(context.Context, *PayoutStakeRequest) (*Transaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayoutStake not implemented")
+}
+func (UnimplementedAccountsServer) RefundStake(context.Context, *RefundStakeRequest) (*Transaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundStake not implemented")
+}
+func (UnimplementedAccountsServer) mustEmbedUnimplementedAccountsServer() {}
+
+// UnsafeAccountsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccountsServer will
+// result in compilation errors.
+type UnsafeAccountsServer interface {
+	mustEmbedUnimplementedAccountsServer()
+}
+
+func RegisterAccountsServer(s grpc.ServiceRegistrar, srv AccountsServer) {
+	s.RegisterService(&Accounts_ServiceDesc, srv)
+}
+
+func _Accounts_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts.Accounts/CreateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts.Accounts/GetBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).GetBalance(ctx, req.(*GetBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts.Accounts/ListTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts.Accounts/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).Deposit(ctx, req.(*DepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts.Accounts/Withdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).Withdraw(ctx, req.(*WithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_GetLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).GetLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts.Accounts/GetLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).GetLimits(ctx, req.(*GetLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_SetLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).SetLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts.Accounts/SetLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).SetLimit(ctx, req.(*SetLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_SelfExclude_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelfExcludeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).SelfExclude(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts.Accounts/SelfExclude",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).SelfExclude(ctx, req.(*SelfExcludeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_ListAuditRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ListAuditRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts.Accounts/ListAuditRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ListAuditRecords(ctx, req.(*ListAuditRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_GetSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).GetSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts.Accounts/GetSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).GetSession(ctx, req.(*GetSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_AcknowledgeReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).AcknowledgeReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts.Accounts/AcknowledgeReminder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).AcknowledgeReminder(ctx, req.(*AcknowledgeReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_ReserveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ReserveStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts.Accounts/ReserveStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ReserveStake(ctx, req.(*ReserveStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_PayoutStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayoutStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).PayoutStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts.Accounts/PayoutStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).PayoutStake(ctx, req.(*PayoutStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_RefundStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).RefundStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts.Accounts/RefundStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).RefundStake(ctx, req.(*RefundStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Accounts_ServiceDesc is the grpc.ServiceDesc for Accounts service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Accounts_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "accounts.Accounts",
+	HandlerType: (*AccountsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAccount",
+			Handler:    _Accounts_CreateAccount_Handler,
+		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _Accounts_GetBalance_Handler,
+		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _Accounts_ListTransactions_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _Accounts_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _Accounts_Withdraw_Handler,
+		},
+		{
+			MethodName: "GetLimits",
+			Handler:    _Accounts_GetLimits_Handler,
+		},
+		{
+			MethodName: "SetLimit",
+			Handler:    _Accounts_SetLimit_Handler,
+		},
+		{
+			MethodName: "SelfExclude",
+			Handler:    _Accounts_SelfExclude_Handler,
+		},
+		{
+			MethodName: "ListAuditRecords",
+			Handler:    _Accounts_ListAuditRecords_Handler,
+		},
+		{
+			MethodName: "GetSession",
+			Handler:    _Accounts_GetSession_Handler,
+		},
+		{
+			MethodName: "AcknowledgeReminder",
+			Handler:    _Accounts_AcknowledgeReminder_Handler,
+		},
+		{
+			MethodName: "ReserveStake",
+			Handler:    _Accounts_ReserveStake_Handler,
+		},
+		{
+			MethodName: "PayoutStake",
+			Handler:    _Accounts_PayoutStake_Handler,
+		},
+		{
+			MethodName: "RefundStake",
+			Handler:    _Accounts_RefundStake_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "accounts/accounts.proto",
+}
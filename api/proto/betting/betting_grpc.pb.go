@@ -0,0 +1,271 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package betting
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// BettingClient is the client API for Betting service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BettingClient interface {
+	// PlaceBet places a bet on a runner of an OPEN race at the runner's
+	// current fixed price, or a multi combining such bets, on several races or
+	// the same one, with bets on participants of OPEN sports events, returning
+	// the bet accepted.
+	PlaceBet(ctx context.Context, in *PlaceBetRequest, opts ...grpc.CallOption) (*Bet, error)
+	// GetBet returns a single bet by its ID.
+	GetBet(ctx context.Context, in *GetBetRequest, opts ...grpc.CallOption) (*Bet, error)
+	// ListBets returns a collection of bets, newest first.
+	ListBets(ctx context.Context, in *ListBetsRequest, opts ...grpc.CallOption) (*ListBetsResponse, error)
+	// SettleRace settles the bets still open on a RESULTED or ABANDONED race,
+	// returning those it settled. Bets are only ever settled once, so settling
+	// a race again settles nothing more. Bets on a race since deleted are
+	// refunded, as for an abandoned race.
+	SettleRace(ctx context.Context, in *SettleRaceRequest, opts ...grpc.CallOption) (*SettleRaceResponse, error)
+	// SettleEvent settles the legs of multis still pending on a RESULTED or
+	// ABANDONED sports event, returning the bets it changed. As with races,
+	// settling an event again settles nothing more.
+	SettleEvent(ctx context.Context, in *SettleEventRequest, opts ...grpc.CallOption) (*SettleEventResponse, error)
+}
+
+type bettingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBettingClient(cc grpc.ClientConnInterface) BettingClient {
+	return &bettingClient{cc}
+}
+
+func (c *bettingClient) PlaceBet(ctx context.Context, in *PlaceBetRequest, opts ...grpc.CallOption) (*Bet, error) {
+	out := new(Bet)
+	err := c.cc.Invoke(ctx, "/betting.Betting/PlaceBet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bettingClient) GetBet(ctx context.Context, in *GetBetRequest, opts ...grpc.CallOption) (*Bet, error) {
+	out := new(Bet)
+	err := c.cc.Invoke(ctx, "/betting.Betting/GetBet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bettingClient) ListBets(ctx context.Context, in *ListBetsRequest, opts ...grpc.CallOption) (*ListBetsResponse, error) {
+	out := new(ListBetsResponse)
+	err := c.cc.Invoke(ctx, "/betting.Betting/ListBets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bettingClient) SettleRace(ctx context.Context, in *SettleRaceRequest, opts ...grpc.CallOption) (*SettleRaceResponse, error) {
+	out := new(SettleRaceResponse)
+	err := c.cc.Invoke(ctx, "/betting.Betting/SettleRace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bettingClient) SettleEvent(ctx context.Context, in *SettleEventRequest, opts ...grpc.CallOption) (*SettleEventResponse, error) {
+	out := new(SettleEventResponse)
+	err := c.cc.Invoke(ctx, "/betting.Betting/SettleEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BettingServer is the server API for Betting service.
+// All implementations must embed UnimplementedBettingServer
+// for forward compatibility
+type BettingServer interface {
+	// PlaceBet places a bet on a runner of an OPEN race at the runner's
+	// current fixed price, or a multi combining such bets, on several races or
+	// the same one, with bets on participants of OPEN sports events, returning
+	// the bet accepted.
+	PlaceBet(context.Context, *PlaceBetRequest) (*Bet, error)
+	// GetBet returns a single bet by its ID.
+	GetBet(context.Context, *GetBetRequest) (*Bet, error)
+	// ListBets returns a collection of bets, newest first.
+	ListBets(context.Context, *ListBetsRequest) (*ListBetsResponse, error)
+	// SettleRace settles the bets still open on a RESULTED or ABANDONED race,
+	// returning those it settled. Bets are only ever settled once, so settling
+	// a race again settles nothing more. Bets on a race since deleted are
+	// refunded, as for an abandoned race.
+	SettleRace(context.Context, *SettleRaceRequest) (*SettleRaceResponse, error)
+	// SettleEvent settles the legs of multis still pending on a RESULTED or
+	// ABANDONED sports event, returning the bets it changed. As with races,
+	// settling an event again settles nothing more.
+	SettleEvent(context.Context, *SettleEventRequest) (*SettleEventResponse, error)
+	mustEmbedUnimplementedBettingServer()
+}
+
+// UnimplementedBettingServer must be embedded to have forward compatible implementations.
+type UnimplementedBettingServer struct {
+}
+
+func (UnimplementedBettingServer) PlaceBet(context.Context, *PlaceBetRequest) (*Bet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBet not implemented")
+}
+func (UnimplementedBettingServer) GetBet(context.Context, *GetBetRequest) (*Bet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBet not implemented")
+}
+func (UnimplementedBettingServer) ListBets(context.Context, *ListBetsRequest) (*ListBetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBets not implemented")
+}
+func (UnimplementedBettingServer) SettleRace(context.Context, *SettleRaceRequest) (*SettleRaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleRace not implemented")
+}
+func (UnimplementedBettingServer) SettleEvent(context.Context, *SettleEventRequest) (*SettleEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleEvent not implemented")
+}
+func (UnimplementedBettingServer) mustEmbedUnimplementedBettingServer() {}
+
+// UnsafeBettingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BettingServer will
+// result in compilation errors.
+type UnsafeBettingServer interface {
+	mustEmbedUnimplementedBettingServer()
+}
+
+func RegisterBettingServer(s grpc.ServiceRegistrar, srv BettingServer) {
+	s.RegisterService(&Betting_ServiceDesc, srv)
+}
+
+func _Betting_PlaceBet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceBetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BettingServer).PlaceBet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/betting.Betting/PlaceBet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BettingServer).PlaceBet(ctx, req.(*PlaceBetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Betting_GetBet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BettingServer).GetBet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/betting.Betting/GetBet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BettingServer).GetBet(ctx, req.(*GetBetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Betting_ListBets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BettingServer).ListBets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/betting.Betting/ListBets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BettingServer).ListBets(ctx, req.(*ListBetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Betting_SettleRace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleRaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BettingServer).SettleRace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/betting.Betting/SettleRace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BettingServer).SettleRace(ctx, req.(*SettleRaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Betting_SettleEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BettingServer).SettleEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/betting.Betting/SettleEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BettingServer).SettleEvent(ctx, req.(*SettleEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Betting_ServiceDesc is the grpc.ServiceDesc for Betting service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Betting_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "betting.Betting",
+	HandlerType: (*BettingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PlaceBet",
+			Handler:    _Betting_PlaceBet_Handler,
+		},
+		{
+			MethodName: "GetBet",
+			Handler:    _Betting_GetBet_Handler,
+		},
+		{
+			MethodName: "ListBets",
+			Handler:    _Betting_ListBets_Handler,
+		},
+		{
+			MethodName: "SettleRace",
+			Handler:    _Betting_SettleRace_Handler,
+		},
+		{
+			MethodName: "SettleEvent",
+			Handler:    _Betting_SettleEvent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "betting/betting.proto",
+}
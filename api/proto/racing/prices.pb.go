@@ -0,0 +1,846 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0-devel
+// 	protoc        v3.13.0
+// source: racing/prices.proto
+
+package racing
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Type of a price.
+type Price_Type int32
+
+const (
+	Price_TYPE_UNSPECIFIED Price_Type = 0
+	// A price fixed by our traders, paid at the odds taken.
+	Price_FIXED Price_Type = 1
+	// An estimated dividend of the tote pool, paid at the final dividend.
+	Price_TOTE Price_Type = 2
+)
+
+// Enum value maps for Price_Type.
+var (
+	Price_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "FIXED",
+		2: "TOTE",
+	}
+	Price_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"FIXED":            1,
+		"TOTE":             2,
+	}
+)
+
+func (x Price_Type) Enum() *Price_Type {
+	p := new(Price_Type)
+	*p = x
+	return p
+}
+
+func (x Price_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Price_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_racing_prices_proto_enumTypes[0].Descriptor()
+}
+
+func (Price_Type) Type() protoreflect.EnumType {
+	return &file_racing_prices_proto_enumTypes[0]
+}
+
+func (x Price_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Price_Type.Descriptor instead.
+func (Price_Type) EnumDescriptor() ([]byte, []int) {
+	return file_racing_prices_proto_rawDescGZIP(), []int{4, 0}
+}
+
+// Type of a price event.
+type PriceEvent_Type int32
+
+const (
+	PriceEvent_TYPE_UNSPECIFIED PriceEvent_Type = 0
+	// The price was current when the watch started.
+	PriceEvent_SNAPSHOT PriceEvent_Type = 1
+	// Every snapshot event has been sent. Carries no price.
+	PriceEvent_SYNCED PriceEvent_Type = 2
+	// A new price was offered.
+	PriceEvent_FLUCTUATED PriceEvent_Type = 3
+)
+
+// Enum value maps for PriceEvent_Type.
+var (
+	PriceEvent_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "SNAPSHOT",
+		2: "SYNCED",
+		3: "FLUCTUATED",
+	}
+	PriceEvent_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"SNAPSHOT":         1,
+		"SYNCED":           2,
+		"FLUCTUATED":       3,
+	}
+)
+
+func (x PriceEvent_Type) Enum() *PriceEvent_Type {
+	p := new(PriceEvent_Type)
+	*p = x
+	return p
+}
+
+func (x PriceEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_racing_prices_proto_enumTypes[1].Descriptor()
+}
+
+func (PriceEvent_Type) Type() protoreflect.EnumType {
+	return &file_racing_prices_proto_enumTypes[1]
+}
+
+func (x PriceEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PriceEvent_Type.Descriptor instead.
+func (PriceEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_racing_prices_proto_rawDescGZIP(), []int{6, 0}
+}
+
+// Request for GetRacePrices call.
+type GetRacePricesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RaceId int64 `protobuf:"varint,1,opt,name=race_id,json=raceId,proto3" json:"race_id,omitempty"`
+	// IncludeHistory represents whether every price each runner has been
+	// offered at is included, rather than only the opening and current ones.
+	IncludeHistory bool `protobuf:"varint,2,opt,name=include_history,json=includeHistory,proto3" json:"include_history,omitempty"`
+}
+
+func (x *GetRacePricesRequest) Reset() {
+	*x = GetRacePricesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_racing_prices_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRacePricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRacePricesRequest) ProtoMessage() {}
+
+func (x *GetRacePricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_racing_prices_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRacePricesRequest.ProtoReflect.Descriptor instead.
+func (*GetRacePricesRequest) Descriptor() ([]byte, []int) {
+	return file_racing_prices_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetRacePricesRequest) GetRaceId() int64 {
+	if x != nil {
+		return x.RaceId
+	}
+	return 0
+}
+
+func (x *GetRacePricesRequest) GetIncludeHistory() bool {
+	if x != nil {
+		return x.IncludeHistory
+	}
+	return false
+}
+
+// Response to GetRacePrices call.
+type GetRacePricesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Prices are ordered by runner number, then type. Runners that have not
+	// been priced are absent.
+	Prices []*RunnerPrices `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"`
+}
+
+func (x *GetRacePricesResponse) Reset() {
+	*x = GetRacePricesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_racing_prices_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRacePricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRacePricesResponse) ProtoMessage() {}
+
+func (x *GetRacePricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_racing_prices_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRacePricesResponse.ProtoReflect.Descriptor instead.
+func (*GetRacePricesResponse) Descriptor() ([]byte, []int) {
+	return file_racing_prices_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRacePricesResponse) GetPrices() []*RunnerPrices {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+// Request for SetPrice call.
+type SetPriceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunnerId int64      `protobuf:"varint,1,opt,name=runner_id,json=runnerId,proto3" json:"runner_id,omitempty"`
+	Type     Price_Type `protobuf:"varint,2,opt,name=type,proto3,enum=racing.Price_Type" json:"type,omitempty"`
+	// Odds are the decimal odds offered, which must be greater than 1.
+	Odds float64 `protobuf:"fixed64,3,opt,name=odds,proto3" json:"odds,omitempty"`
+}
+
+func (x *SetPriceRequest) Reset() {
+	*x = SetPriceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_racing_prices_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceRequest) ProtoMessage() {}
+
+func (x *SetPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_racing_prices_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceRequest.ProtoReflect.Descriptor instead.
+func (*SetPriceRequest) Descriptor() ([]byte, []int) {
+	return file_racing_prices_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetPriceRequest) GetRunnerId() int64 {
+	if x != nil {
+		return x.RunnerId
+	}
+	return 0
+}
+
+func (x *SetPriceRequest) GetType() Price_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Price_TYPE_UNSPECIFIED
+}
+
+func (x *SetPriceRequest) GetOdds() float64 {
+	if x != nil {
+		return x.Odds
+	}
+	return 0
+}
+
+// Request for WatchPrices call.
+type WatchPricesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RaceId int64 `protobuf:"varint,1,opt,name=race_id,json=raceId,proto3" json:"race_id,omitempty"`
+}
+
+func (x *WatchPricesRequest) Reset() {
+	*x = WatchPricesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_racing_prices_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPricesRequest) ProtoMessage() {}
+
+func (x *WatchPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_racing_prices_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPricesRequest.ProtoReflect.Descriptor instead.
+func (*WatchPricesRequest) Descriptor() ([]byte, []int) {
+	return file_racing_prices_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WatchPricesRequest) GetRaceId() int64 {
+	if x != nil {
+		return x.RaceId
+	}
+	return 0
+}
+
+// A price offered on a runner: the decimal odds it pays to win.
+type Price struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RunnerID represents a unique identifier for the runner priced.
+	RunnerId int64 `protobuf:"varint,1,opt,name=runner_id,json=runnerId,proto3" json:"runner_id,omitempty"`
+	// RaceID represents a unique identifier for the runner's race.
+	RaceId int64 `protobuf:"varint,2,opt,name=race_id,json=raceId,proto3" json:"race_id,omitempty"`
+	// Type of the price.
+	Type Price_Type `protobuf:"varint,3,opt,name=type,proto3,enum=racing.Price_Type" json:"type,omitempty"`
+	// Odds are the decimal odds, the return on a unit stake including the
+	// stake, e.g. 3.5.
+	Odds float64 `protobuf:"fixed64,4,opt,name=odds,proto3" json:"odds,omitempty"`
+	// RecordedAt is the time the price was offered from.
+	RecordedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+}
+
+func (x *Price) Reset() {
+	*x = Price{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_racing_prices_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Price) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Price) ProtoMessage() {}
+
+func (x *Price) ProtoReflect() protoreflect.Message {
+	mi := &file_racing_prices_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Price.ProtoReflect.Descriptor instead.
+func (*Price) Descriptor() ([]byte, []int) {
+	return file_racing_prices_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Price) GetRunnerId() int64 {
+	if x != nil {
+		return x.RunnerId
+	}
+	return 0
+}
+
+func (x *Price) GetRaceId() int64 {
+	if x != nil {
+		return x.RaceId
+	}
+	return 0
+}
+
+func (x *Price) GetType() Price_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Price_TYPE_UNSPECIFIED
+}
+
+func (x *Price) GetOdds() float64 {
+	if x != nil {
+		return x.Odds
+	}
+	return 0
+}
+
+func (x *Price) GetRecordedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return nil
+}
+
+// The prices of a runner of one type.
+type RunnerPrices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RunnerID represents a unique identifier for the runner priced.
+	RunnerId int64 `protobuf:"varint,1,opt,name=runner_id,json=runnerId,proto3" json:"runner_id,omitempty"`
+	// Type of the prices.
+	Type Price_Type `protobuf:"varint,2,opt,name=type,proto3,enum=racing.Price_Type" json:"type,omitempty"`
+	// Opening is the first price offered.
+	Opening *Price `protobuf:"bytes,3,opt,name=opening,proto3" json:"opening,omitempty"`
+	// Current is the latest price offered.
+	Current *Price `protobuf:"bytes,4,opt,name=current,proto3" json:"current,omitempty"`
+	// History is every price offered, oldest first. It is only included when
+	// requested.
+	History []*Price `protobuf:"bytes,5,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *RunnerPrices) Reset() {
+	*x = RunnerPrices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_racing_prices_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunnerPrices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunnerPrices) ProtoMessage() {}
+
+func (x *RunnerPrices) ProtoReflect() protoreflect.Message {
+	mi := &file_racing_prices_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunnerPrices.ProtoReflect.Descriptor instead.
+func (*RunnerPrices) Descriptor() ([]byte, []int) {
+	return file_racing_prices_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RunnerPrices) GetRunnerId() int64 {
+	if x != nil {
+		return x.RunnerId
+	}
+	return 0
+}
+
+func (x *RunnerPrices) GetType() Price_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Price_TYPE_UNSPECIFIED
+}
+
+func (x *RunnerPrices) GetOpening() *Price {
+	if x != nil {
+		return x.Opening
+	}
+	return nil
+}
+
+func (x *RunnerPrices) GetCurrent() *Price {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *RunnerPrices) GetHistory() []*Price {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// An event streamed by WatchPrices.
+type PriceEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the event.
+	Type PriceEvent_Type `protobuf:"varint,1,opt,name=type,proto3,enum=racing.PriceEvent_Type" json:"type,omitempty"`
+	// Price is the current price, as it is after the event.
+	Price *Price `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	// Previous is the price that fluctuated, unset for opening prices and
+	// snapshot events.
+	Previous *Price `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+}
+
+func (x *PriceEvent) Reset() {
+	*x = PriceEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_racing_prices_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceEvent) ProtoMessage() {}
+
+func (x *PriceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_racing_prices_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceEvent.ProtoReflect.Descriptor instead.
+func (*PriceEvent) Descriptor() ([]byte, []int) {
+	return file_racing_prices_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PriceEvent) GetType() PriceEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return PriceEvent_TYPE_UNSPECIFIED
+}
+
+func (x *PriceEvent) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PriceEvent) GetPrevious() *Price {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+var File_racing_prices_proto protoreflect.FileDescriptor
+
+var file_racing_prices_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x58, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a,
+	0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x45, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63,
+	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x22, 0x6a, 0x0a,
+	0x0f, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x72, 0x61,
+	0x63, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x64, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x6f, 0x64, 0x64, 0x73, 0x22, 0x2d, 0x0a, 0x12, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x22, 0xe9, 0x01, 0x0a, 0x05, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6f, 0x64, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x6f, 0x64, 0x64, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x31, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x46, 0x49, 0x58, 0x45, 0x44, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x4f,
+	0x54, 0x45, 0x10, 0x02, 0x22, 0xce, 0x01, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x61,
+	0x63, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x07,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x07, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xd1, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x23, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x61, 0x63, 0x69, 0x6e,
+	0x67, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x22, 0x46, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x53, 0x59, 0x4e, 0x43, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x4c, 0x55,
+	0x43, 0x54, 0x55, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x32, 0x9c, 0x02, 0x0a, 0x06, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x70, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x65, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x61, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x61, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x17, 0x2e, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x74, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x72, 0x61,
+	0x63, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x23, 0x3a, 0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x75, 0x6e, 0x6e, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x12, 0x2e, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x42, 0x09, 0x5a, 0x07, 0x2f, 0x72, 0x61, 0x63,
+	0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_racing_prices_proto_rawDescOnce sync.Once
+	file_racing_prices_proto_rawDescData = file_racing_prices_proto_rawDesc
+)
+
+func file_racing_prices_proto_rawDescGZIP() []byte {
+	file_racing_prices_proto_rawDescOnce.Do(func() {
+		file_racing_prices_proto_rawDescData = protoimpl.X.CompressGZIP(file_racing_prices_proto_rawDescData)
+	})
+	return file_racing_prices_proto_rawDescData
+}
+
+var file_racing_prices_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_racing_prices_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_racing_prices_proto_goTypes = []interface{}{
+	(Price_Type)(0),               // 0: racing.Price.Type
+	(PriceEvent_Type)(0),          // 1: racing.PriceEvent.Type
+	(*GetRacePricesRequest)(nil),  // 2: racing.GetRacePricesRequest
+	(*GetRacePricesResponse)(nil), // 3: racing.GetRacePricesResponse
+	(*SetPriceRequest)(nil),       // 4: racing.SetPriceRequest
+	(*WatchPricesRequest)(nil),    // 5: racing.WatchPricesRequest
+	(*Price)(nil),                 // 6: racing.Price
+	(*RunnerPrices)(nil),          // 7: racing.RunnerPrices
+	(*PriceEvent)(nil),            // 8: racing.PriceEvent
+	(*timestamp.Timestamp)(nil),   // 9: google.protobuf.Timestamp
+}
+var file_racing_prices_proto_depIdxs = []int32{
+	7,  // 0: racing.GetRacePricesResponse.prices:type_name -> racing.RunnerPrices
+	0,  // 1: racing.SetPriceRequest.type:type_name -> racing.Price.Type
+	0,  // 2: racing.Price.type:type_name -> racing.Price.Type
+	9,  // 3: racing.Price.recorded_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: racing.RunnerPrices.type:type_name -> racing.Price.Type
+	6,  // 5: racing.RunnerPrices.opening:type_name -> racing.Price
+	6,  // 6: racing.RunnerPrices.current:type_name -> racing.Price
+	6,  // 7: racing.RunnerPrices.history:type_name -> racing.Price
+	1,  // 8: racing.PriceEvent.type:type_name -> racing.PriceEvent.Type
+	6,  // 9: racing.PriceEvent.price:type_name -> racing.Price
+	6,  // 10: racing.PriceEvent.previous:type_name -> racing.Price
+	2,  // 11: racing.Prices.GetRacePrices:input_type -> racing.GetRacePricesRequest
+	4,  // 12: racing.Prices.SetPrice:input_type -> racing.SetPriceRequest
+	5,  // 13: racing.Prices.WatchPrices:input_type -> racing.WatchPricesRequest
+	3,  // 14: racing.Prices.GetRacePrices:output_type -> racing.GetRacePricesResponse
+	6,  // 15: racing.Prices.SetPrice:output_type -> racing.Price
+	8,  // 16: racing.Prices.WatchPrices:output_type -> racing.PriceEvent
+	14, // [14:17] is the sub-list for method output_type
+	11, // [11:14] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_racing_prices_proto_init() }
+func file_racing_prices_proto_init() {
+	if File_racing_prices_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_racing_prices_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRacePricesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_racing_prices_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRacePricesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_racing_prices_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPriceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_racing_prices_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchPricesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_racing_prices_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Price); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_racing_prices_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunnerPrices); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_racing_prices_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_racing_prices_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_racing_prices_proto_goTypes,
+		DependencyIndexes: file_racing_prices_proto_depIdxs,
+		EnumInfos:         file_racing_prices_proto_enumTypes,
+		MessageInfos:      file_racing_prices_proto_msgTypes,
+	}.Build()
+	File_racing_prices_proto = out.File
+	file_racing_prices_proto_rawDesc = nil
+	file_racing_prices_proto_goTypes = nil
+	file_racing_prices_proto_depIdxs = nil
+}
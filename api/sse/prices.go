@@ -0,0 +1,56 @@
+package sse
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PriceWatcher bridges WatchPrices onto a text/event-stream response.
+type PriceWatcher struct {
+	bridge
+	client racing.PricesClient
+}
+
+// NewPriceWatcher creates a handler streaming price events from the client,
+// writing a heartbeat comment at the given interval so that proxies keep idle
+// connections open.
+func NewPriceWatcher(mux *runtime.ServeMux, client racing.PricesClient, heartbeat time.Duration) *PriceWatcher {
+	return &PriceWatcher{bridge: newBridge(mux, heartbeat), client: client}
+}
+
+// Handle streams the price events of the race named by the race_id path
+// parameter as server-sent events, and is registered with the mux as a custom
+// path handler.
+//
+// Each event's type is named after the PriceEvent type, lower cased, and its
+// data is the PriceEvent as JSON. Price events carry no id, as the current
+// prices are sent afresh to a client that reconnects.
+func (w *PriceWatcher) Handle(rw http.ResponseWriter, r *http.Request, params map[string]string) {
+	raceID, err := strconv.ParseInt(params["race_id"], 10, 64)
+	if err != nil {
+		w.error(rw, r, status.Errorf(codes.InvalidArgument, "invalid race_id %q", params["race_id"]))
+		return
+	}
+
+	stream, err := w.client.WatchPrices(r.Context(), &racing.WatchPricesRequest{RaceId: raceID})
+	if err != nil {
+		w.error(rw, r, err)
+		return
+	}
+
+	w.serve(rw, r, "price watch", func() (event, error) {
+		priceEvent, err := stream.Recv()
+		if err != nil {
+			return event{}, err
+		}
+
+		return event{name: strings.ToLower(priceEvent.Type.String()), data: priceEvent}, nil
+	})
+}
@@ -0,0 +1,80 @@
+// Package sse bridges streaming gRPC calls onto server-sent events, for
+// clients such as browsers that cannot consume gRPC streams directly.
+package sse
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RaceWatcher bridges WatchRaces onto a text/event-stream response.
+type RaceWatcher struct {
+	bridge
+	client racing.RacingClient
+}
+
+// NewRaceWatcher creates a handler streaming race events from the client,
+// writing a heartbeat comment at the given interval so that proxies keep idle
+// connections open. Errors are written using the mux's error handler, as they
+// would be for any gateway call.
+func NewRaceWatcher(mux *runtime.ServeMux, client racing.RacingClient, heartbeat time.Duration) *RaceWatcher {
+	return &RaceWatcher{bridge: newBridge(mux, heartbeat), client: client}
+}
+
+// Handle streams race events as server-sent events, and is registered with
+// the mux as a custom path handler. The request is taken from query
+// parameters named after the WatchRacesRequest fields, e.g.
+// ?filter.visibility=VISIBILITY_VISIBLE&filter.meeting_ids=1. A client
+// reconnecting with a Last-Event-ID header, or resume_after parameter,
+// resumes from that event.
+//
+// Each event's type is named after the RaceEvent type, lower cased, and its
+// data is the RaceEvent as JSON. Only events that mark a resumable position
+// carry an id: snapshot events share one, which is sent with the SYNCED event
+// closing the snapshot, so a client disconnected part way through a snapshot
+// is sent a fresh one.
+func (w *RaceWatcher) Handle(rw http.ResponseWriter, r *http.Request, _ map[string]string) {
+	in := &racing.WatchRacesRequest{}
+	if err := runtime.PopulateQueryParameters(in, r.URL.Query(), &utilities.DoubleArray{}); err != nil {
+		w.error(rw, r, status.Error(codes.InvalidArgument, err.Error()))
+		return
+	}
+
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+		resumeAfter, err := strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil {
+			w.error(rw, r, status.Errorf(codes.InvalidArgument, "invalid Last-Event-ID %q", lastEventID))
+			return
+		}
+
+		in.ResumeAfter = resumeAfter
+	}
+
+	stream, err := w.client.WatchRaces(r.Context(), in)
+	if err != nil {
+		w.error(rw, r, err)
+		return
+	}
+
+	w.serve(rw, r, "race watch", func() (event, error) {
+		raceEvent, err := stream.Recv()
+		if err != nil {
+			return event{}, err
+		}
+
+		e := event{name: strings.ToLower(raceEvent.Type.String()), data: raceEvent}
+		if raceEvent.Type != racing.RaceEvent_SNAPSHOT {
+			e.id = strconv.FormatInt(raceEvent.Sequence, 10)
+		}
+
+		return e, nil
+	})
+}
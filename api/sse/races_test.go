@@ -0,0 +1,497 @@
+package sse
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// historySize is the number of recent changes the racing service retains for
+// watchers resuming after a disconnect.
+const historySize = 1024
+
+// fakeRacingClient answers WatchRaces as the racing service does: a watcher
+// resuming from a change still retained is replayed the changes since, and
+// any other watcher is sent a snapshot of the races, each followed by a
+// SYNCED event and then changes as they are made.
+type fakeRacingClient struct {
+	racing.RacingClient
+
+	// sequence is that of the last change made.
+	sequence int64
+	// races are sent in snapshots.
+	races []*racing.Race
+	// live are sent once synced, until it is closed.
+	live chan *racing.RaceEvent
+	// err fails the call, and recvErr its stream before any event.
+	err, recvErr error
+
+	mu       sync.Mutex
+	requests []*racing.WatchRacesRequest
+}
+
+func (c *fakeRacingClient) WatchRaces(ctx context.Context, in *racing.WatchRacesRequest, _ ...grpc.CallOption) (racing.Racing_WatchRacesClient, error) {
+	c.mu.Lock()
+	c.requests = append(c.requests, in)
+	c.mu.Unlock()
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	var events []*racing.RaceEvent
+
+	// Only the last historySize changes are retained.
+	if oldest := c.sequence - historySize + 1; in.ResumeAfter > 0 && in.ResumeAfter <= c.sequence && in.ResumeAfter >= oldest-1 {
+		for sequence := in.ResumeAfter + 1; sequence <= c.sequence; sequence++ {
+			events = append(events, &racing.RaceEvent{Type: racing.RaceEvent_UPDATED, Race: &racing.Race{Id: 1}, Sequence: sequence})
+		}
+	} else {
+		for _, race := range c.races {
+			events = append(events, &racing.RaceEvent{Type: racing.RaceEvent_SNAPSHOT, Race: race, Sequence: c.sequence})
+		}
+	}
+
+	events = append(events, &racing.RaceEvent{Type: racing.RaceEvent_SYNCED, Sequence: c.sequence})
+
+	return &fakeWatchRacesStream{ctx: ctx, events: events, live: c.live, err: c.recvErr}, nil
+}
+
+// calls returns the number of WatchRaces calls made.
+func (c *fakeRacingClient) calls() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.requests)
+}
+
+// request returns the only WatchRaces request made, failing the test unless
+// exactly one was.
+func (c *fakeRacingClient) request(t *testing.T) *racing.WatchRacesRequest {
+	t.Helper()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.requests) != 1 {
+		t.Fatalf("made %d WatchRaces requests, want 1", len(c.requests))
+	}
+
+	return c.requests[0]
+}
+
+// fakeWatchRacesStream receives the events given, then the live events.
+type fakeWatchRacesStream struct {
+	grpc.ClientStream
+
+	ctx    context.Context
+	events []*racing.RaceEvent
+	live   <-chan *racing.RaceEvent
+	err    error
+}
+
+func (s *fakeWatchRacesStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchRacesStream) Recv() (*racing.RaceEvent, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	if len(s.events) > 0 {
+		event := s.events[0]
+		s.events = s.events[1:]
+
+		return event, nil
+	}
+
+	select {
+	case event, ok := <-s.live:
+		if !ok {
+			return nil, io.EOF
+		}
+
+		return event, nil
+	case <-s.ctx.Done():
+		return nil, status.FromContextError(s.ctx.Err()).Err()
+	}
+}
+
+// frame is an event stream frame: an event, a comment or a retry hint.
+type frame struct {
+	id      string
+	name    string
+	data    *racing.RaceEvent
+	comment string
+	retry   string
+}
+
+// watchRaces serves the race watcher with the client, and requests the path
+// with the given Last-Event-ID header, if any. The request is cancelled when
+// the test ends.
+func watchRaces(t *testing.T, client racing.RacingClient, heartbeat time.Duration, path, lastEventID string) *http.Response {
+	t.Helper()
+
+	mux := runtime.NewServeMux()
+	if err := mux.HandlePath(http.MethodGet, "/v1/races:watch", NewRaceWatcher(mux, client, heartbeat).Handle); err != nil {
+		t.Fatalf("registering handler: %s", err)
+	}
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+path, nil)
+	if err != nil {
+		t.Fatalf("creating request: %s", err)
+	}
+
+	if lastEventID != "" {
+		request.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	response, err := server.Client().Do(request)
+	if err != nil {
+		t.Fatalf("requesting %s: %s", path, err)
+	}
+	t.Cleanup(func() { _ = response.Body.Close() })
+
+	return response
+}
+
+// checkEventStream fails the test unless the response is an event stream.
+func checkEventStream(t *testing.T, response *http.Response) {
+	t.Helper()
+
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("got status %d, want %d", response.StatusCode, http.StatusOK)
+	}
+
+	if contentType := response.Header.Get("Content-Type"); contentType != "text/event-stream" {
+		t.Fatalf("got content type %q, want text/event-stream", contentType)
+	}
+}
+
+// frameReader reads the frames of an event stream.
+type frameReader struct {
+	frames <-chan frame
+	err    <-chan error
+}
+
+// newFrameReader reads frames from an event stream until it ends or the test
+// does.
+func newFrameReader(t *testing.T, body io.Reader) *frameReader {
+	var (
+		frames = make(chan frame)
+		failed = make(chan error, 1)
+		stop   = make(chan struct{})
+	)
+
+	t.Cleanup(func() { close(stop) })
+
+	go func() {
+		defer close(frames)
+
+		scanner := bufio.NewScanner(body)
+
+		var current frame
+
+		for scanner.Scan() {
+			line := scanner.Text()
+
+			switch {
+			case line == "":
+				select {
+				case frames <- current:
+				case <-stop:
+					return
+				}
+
+				current = frame{}
+			case strings.HasPrefix(line, ":"):
+				current.comment = strings.TrimSpace(line[1:])
+			case strings.HasPrefix(line, "id: "):
+				current.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				current.name = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "retry: "):
+				current.retry = strings.TrimPrefix(line, "retry: ")
+			case strings.HasPrefix(line, "data: "):
+				current.data = &racing.RaceEvent{}
+				if err := protojson.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), current.data); err != nil {
+					failed <- err
+					return
+				}
+			}
+		}
+
+		failed <- scanner.Err()
+	}()
+
+	return &frameReader{frames: frames, err: failed}
+}
+
+// readUntil reads frames until one is read for which done returns true,
+// failing the test should the stream end or stall first.
+func (r *frameReader) readUntil(t *testing.T, done func(frame) bool) []frame {
+	t.Helper()
+
+	var read []frame
+
+	for {
+		select {
+		case f, ok := <-r.frames:
+			if !ok {
+				t.Fatalf("stream ended after %v: %v", read, <-r.err)
+			}
+
+			read = append(read, f)
+
+			if done(f) {
+				return read
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("stream stalled after %v", read)
+		}
+	}
+}
+
+// synced reports whether a frame is the SYNCED event.
+func synced(f frame) bool {
+	return f.name == "synced"
+}
+
+// describeFrames renders the events of frames as "name#id", to compare
+// against what was expected, skipping comments and retry hints.
+func describeFrames(frames []frame) []string {
+	var described []string
+
+	for _, f := range frames {
+		if f.name == "" {
+			continue
+		}
+
+		described = append(described, f.name+"#"+f.id)
+	}
+
+	return described
+}
+
+func TestRaceWatcherSnapshot(t *testing.T) {
+	client := &fakeRacingClient{sequence: 7, races: []*racing.Race{{Id: 1}, {Id: 2}}}
+
+	response := watchRaces(t, client, time.Minute, "/v1/races:watch?filter.meeting_ids=3", "")
+	checkEventStream(t, response)
+
+	frames := newFrameReader(t, response.Body).readUntil(t, synced)
+
+	// The retry hint comes first, and snapshot events carry no id, so a
+	// client disconnected part way through is sent a fresh snapshot.
+	if frames[0].retry != strconv.FormatInt(retryInterval.Milliseconds(), 10) {
+		t.Errorf("got first frame %+v, want a retry hint of %s", frames[0], retryInterval)
+	}
+
+	if got, want := strings.Join(describeFrames(frames), " "), "snapshot# snapshot# synced#7"; got != want {
+		t.Errorf("got events %s, want %s", got, want)
+	}
+
+	if frames[1].data.GetRace().GetId() != 1 || frames[2].data.GetRace().GetId() != 2 {
+		t.Errorf("got snapshot of races %v and %v, want 1 and 2", frames[1].data.GetRace(), frames[2].data.GetRace())
+	}
+
+	if in := client.request(t); in.ResumeAfter != 0 || len(in.GetFilter().GetMeetingIds()) != 1 || in.Filter.MeetingIds[0] != 3 {
+		t.Errorf("got request %v, want meeting 3 without resuming", in)
+	}
+}
+
+func TestRaceWatcherResume(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		path        string
+		lastEventID string
+		resumeAfter int64
+		want        string
+	}{
+		{
+			name:        "last event id",
+			path:        "/v1/races:watch",
+			lastEventID: "1998",
+			resumeAfter: 1998,
+			want:        "updated#1999 updated#2000 synced#2000",
+		},
+		{
+			name:        "resume after",
+			path:        "/v1/races:watch?resume_after=1999",
+			resumeAfter: 1999,
+			want:        "updated#2000 synced#2000",
+		},
+		{
+			name:        "last event id overrides resume after",
+			path:        "/v1/races:watch?resume_after=5",
+			lastEventID: "1999",
+			resumeAfter: 1999,
+			want:        "updated#2000 synced#2000",
+		},
+		{
+			name:        "oldest retained",
+			path:        "/v1/races:watch",
+			lastEventID: strconv.Itoa(2000 - historySize),
+			resumeAfter: 2000 - historySize,
+			want:        strings.Repeat("updated ", historySize) + "synced#2000",
+		},
+		{
+			name:        "older than history",
+			path:        "/v1/races:watch",
+			lastEventID: strconv.Itoa(2000 - historySize - 1),
+			resumeAfter: 2000 - historySize - 1,
+			want:        "snapshot# synced#2000",
+		},
+		{
+			name:        "current",
+			path:        "/v1/races:watch",
+			lastEventID: "2000",
+			resumeAfter: 2000,
+			want:        "synced#2000",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			client := &fakeRacingClient{sequence: 2000, races: []*racing.Race{{Id: 1}}}
+
+			response := watchRaces(t, client, time.Minute, test.path, test.lastEventID)
+			checkEventStream(t, response)
+
+			got := describeFrames(newFrameReader(t, response.Body).readUntil(t, synced))
+
+			// Long replays are compared by type, as each is checked to carry
+			// its own sequence below.
+			want := strings.Fields(test.want)
+			if len(got) > 10 {
+				for i, event := range got[:len(got)-1] {
+					if event != "updated#"+strconv.FormatInt(test.resumeAfter+int64(i)+1, 10) {
+						t.Fatalf("got event %d %s, want the change after %d", i, event, test.resumeAfter+int64(i))
+					}
+
+					got[i] = "updated"
+				}
+			}
+
+			if strings.Join(got, " ") != strings.Join(want, " ") {
+				t.Errorf("got events %v, want %v", got, want)
+			}
+
+			if in := client.request(t); in.ResumeAfter != test.resumeAfter {
+				t.Errorf("resumed after %d, want %d", in.ResumeAfter, test.resumeAfter)
+			}
+		})
+	}
+}
+
+func TestRaceWatcherLiveEventsAndHeartbeat(t *testing.T) {
+	client := &fakeRacingClient{sequence: 7, live: make(chan *racing.RaceEvent)}
+
+	response := watchRaces(t, client, 10*time.Millisecond, "/v1/races:watch", "")
+	checkEventStream(t, response)
+
+	frames := newFrameReader(t, response.Body)
+	frames.readUntil(t, synced)
+
+	// An idle stream is kept open with heartbeat comments.
+	frames.readUntil(t, func(f frame) bool {
+		if f.name != "" {
+			t.Fatalf("got event %+v while idle, want heartbeats", f)
+		}
+
+		return f.comment == "heartbeat"
+	})
+
+	client.live <- &racing.RaceEvent{Type: racing.RaceEvent_CREATED, Race: &racing.Race{Id: 9}, Sequence: 8}
+
+	read := frames.readUntil(t, func(f frame) bool { return f.name != "" })
+	if last := read[len(read)-1]; last.name != "created" || last.id != "8" || last.data.GetRace().GetId() != 9 {
+		t.Errorf("got event %+v, want race 9 created with id 8", last)
+	}
+}
+
+func TestRaceWatcherErrors(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		client      *fakeRacingClient
+		path        string
+		lastEventID string
+		status      int
+		called      bool
+	}{
+		{
+			name:        "invalid last event id",
+			client:      &fakeRacingClient{},
+			path:        "/v1/races:watch",
+			lastEventID: "latest",
+			status:      http.StatusBadRequest,
+		},
+		{
+			name:   "invalid query parameter",
+			client: &fakeRacingClient{},
+			path:   "/v1/races:watch?resume_after=soon",
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "call fails",
+			client: &fakeRacingClient{err: status.Error(codes.Unavailable, "racing service is down")},
+			path:   "/v1/races:watch",
+			status: http.StatusServiceUnavailable,
+			called: true,
+		},
+		{
+			// The stream fails before its first event, as racing does when
+			// validating the request, so the response has not yet begun.
+			name:   "stream fails",
+			client: &fakeRacingClient{recvErr: status.Error(codes.InvalidArgument, "invalid filter.meeting_ids")},
+			path:   "/v1/races:watch?filter.meeting_ids=-1",
+			status: http.StatusBadRequest,
+			called: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			response := watchRaces(t, test.client, time.Minute, test.path, test.lastEventID)
+
+			if response.StatusCode != test.status {
+				t.Errorf("got status %d, want %d", response.StatusCode, test.status)
+			}
+
+			if contentType := response.Header.Get("Content-Type"); contentType != "application/json" {
+				t.Errorf("got content type %q, want application/json", contentType)
+			}
+
+			var body struct {
+				Code    int    `json:"code"`
+				Message string `json:"message"`
+			}
+
+			if err := json.NewDecoder(response.Body).Decode(&body); err != nil || body.Message == "" {
+				t.Errorf("got error body %+v, %v, want a status", body, err)
+			}
+
+			if called := test.client.calls() > 0; called != test.called {
+				t.Errorf("called WatchRaces: %t, want %t", called, test.called)
+			}
+		})
+	}
+}
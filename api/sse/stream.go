@@ -0,0 +1,157 @@
+package sse
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// retryInterval is the reconnection delay suggested to clients.
+const retryInterval = 3 * time.Second
+
+// event is a message received from a gRPC stream, as written to an event
+// stream.
+type event struct {
+	// id marks a resumable position in the stream, empty for events that do
+	// not mark one.
+	id string
+	// name is the type of the event.
+	name string
+	// data is written as JSON.
+	data proto.Message
+}
+
+// bridge writes the events received from gRPC streams as server-sent events,
+// writing a heartbeat comment at the given interval so that proxies keep idle
+// connections open. Errors are written using the mux's error handler, as they
+// would be for any gateway call.
+type bridge struct {
+	mux       *runtime.ServeMux
+	heartbeat time.Duration
+	marshaler runtime.Marshaler
+}
+
+func newBridge(mux *runtime.ServeMux, heartbeat time.Duration) bridge {
+	return bridge{mux: mux, heartbeat: heartbeat, marshaler: &runtime.JSONPb{}}
+}
+
+// serve streams the events returned by recv, which is called until it fails,
+// as a text/event-stream response. The stream is described by name in logs.
+func (b bridge) serve(rw http.ResponseWriter, r *http.Request, name string, recv func() (event, error)) {
+	ctx := r.Context()
+
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		b.error(rw, r, status.Error(codes.Unimplemented, "streaming is not supported"))
+		return
+	}
+
+	// Receive on a separate goroutine so heartbeats can be interleaved while
+	// the stream is idle.
+	events := make(chan event)
+	errs := make(chan error, 1)
+
+	go func() {
+		for {
+			e, err := recv()
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			select {
+			case events <- e:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// Wait for the first event before committing to a streaming response, so
+	// a failed call can still be reported with an error status.
+	var first event
+
+	select {
+	case first = <-events:
+	case err := <-errs:
+		b.error(rw, r, err)
+		return
+	case <-ctx.Done():
+		return
+	}
+
+	header := rw.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	rw.WriteHeader(http.StatusOK)
+
+	fmt.Fprintf(rw, "retry: %d\n\n", retryInterval.Milliseconds())
+
+	if err := b.writeEvent(rw, first); err != nil {
+		return
+	}
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(b.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-events:
+			if err := b.writeEvent(rw, e); err != nil {
+				return
+			}
+		case err := <-errs:
+			if err != io.EOF {
+				log.Printf("%s stream ended: %s\n", name, err)
+			}
+
+			return
+		case <-heartbeat.C:
+			if _, err := io.WriteString(rw, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+
+		flusher.Flush()
+	}
+}
+
+// writeEvent writes an event in the event stream format.
+func (b bridge) writeEvent(rw io.Writer, e event) error {
+	data, err := b.marshaler.Marshal(e.data)
+	if err != nil {
+		return err
+	}
+
+	var frame strings.Builder
+
+	if e.id != "" {
+		fmt.Fprintf(&frame, "id: %s\n", e.id)
+	}
+
+	fmt.Fprintf(&frame, "event: %s\n", e.name)
+	fmt.Fprintf(&frame, "data: %s\n\n", data)
+
+	_, err = io.WriteString(rw, frame.String())
+
+	return err
+}
+
+// error writes err using the mux's configured error handler.
+func (b bridge) error(rw http.ResponseWriter, r *http.Request, err error) {
+	_, outbound := runtime.MarshalerForRequest(b.mux, r)
+	runtime.HTTPError(r.Context(), b.mux, outbound, rw, r, err)
+}
@@ -0,0 +1,735 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/protobuf/proto"
+
+	"git.neds.sh/matty/entain/betting/proto/betting"
+)
+
+const (
+	// defaultPageSize is used when the caller does not specify a page size.
+	defaultPageSize = 50
+	// maxPageSize caps the number of bets returned in a single page.
+	maxPageSize = 100
+)
+
+var (
+	// ErrBetNotFound is returned when a bet does not exist.
+	ErrBetNotFound = errors.New("bet not found")
+
+	// ErrLiabilityExceeded is returned when accepting a bet would take the
+	// liability of its runner, race or sports event over the limit.
+	ErrLiabilityExceeded = errors.New("liability limit exceeded")
+
+	// ErrInvalidPageToken is returned when a page token cannot be decoded or
+	// was issued for a different filter.
+	ErrInvalidPageToken = errors.New("invalid page_token")
+)
+
+// LiabilityLimits caps the amount, in cents, that could be lost on the bets
+// still open on a runner, on a race, and on a sports event. A bet's
+// liability is its potential return less its stake. A race's liability
+// counts every open bet on the race, whichever runner wins, so it is never
+// understated, and an event's likewise.
+type LiabilityLimits struct {
+	Runner int64
+	Race   int64
+	Event  int64
+}
+
+// BetsRepo provides repository access to bets.
+type BetsRepo interface {
+	// Init will initialise our bets repository.
+	Init(ctx context.Context) error
+
+	// Get will return a single bet by its ID, or ErrBetNotFound.
+	Get(ctx context.Context, id int64) (*betting.Bet, error)
+
+	// List will return a page of bets matching the request's filter, newest
+	// first, along with the token of the next page, if any.
+	List(ctx context.Context, in *betting.ListBetsRequest) ([]*betting.Bet, string, error)
+
+	// Create will accept a new bet, returning it with its assigned ID and
+	// time, or ErrLiabilityExceeded. The liability of a multi counts in full
+	// against the runner and race, or sports event, of each of its legs. The
+	// limits are checked and the bet inserted atomically.
+	Create(ctx context.Context, bet *betting.Bet, limits LiabilityLimits) (*betting.Bet, error)
+
+	// ListOpen will return the bets still to be settled on a race, by ID:
+	// single bets on it not yet settled, and multis with a leg on it not yet
+	// settled.
+	ListOpen(ctx context.Context, raceID int64) ([]*betting.Bet, error)
+
+	// ListOpenOnEvent will return the multis with a leg on a sports event not
+	// yet settled, by ID.
+	ListOpenOnEvent(ctx context.Context, eventID int64) ([]*betting.Bet, error)
+
+	// ListPendingEvents will return the IDs of the sports events with legs
+	// not yet settled on them, in order.
+	ListPendingEvents(ctx context.Context) ([]int64, error)
+
+	// Settle will settle bets and the legs of multis, recording a settlement
+	// for each bet settled, and return the bets changed by ID. Bets no longer
+	// ACCEPTED and legs no longer PENDING are skipped, so settling them again
+	// has no effect. Either every settlement given is made or none is.
+	Settle(ctx context.Context, settlements []Settlement) ([]*betting.Bet, error)
+
+	// ListUnpaid will return the settlements of bets placed from accounts
+	// that are yet to be paid to them, oldest first. Settlements whose
+	// payment failed are not returned.
+	ListUnpaid(ctx context.Context) ([]Payment, error)
+
+	// MarkPaid will record that the settlement of a bet has been paid.
+	MarkPaid(ctx context.Context, betID int64) error
+
+	// MarkPaymentFailed will record that paying the settlement of a bet
+	// failed for good, and why, so it is no longer listed as unpaid.
+	MarkPaymentFailed(ctx context.Context, betID int64, reason string) error
+}
+
+// Payment is a settlement to be paid to the account a bet was placed from.
+type Payment struct {
+	BetID     int64
+	AccountID int64
+	// StakeReference is the reference the bet's stake was reserved with.
+	StakeReference string
+	// Status is WON, LOST or REFUNDED.
+	Status betting.Bet_Status
+	// Payout is the amount returned, in cents.
+	Payout int64
+}
+
+// Settlement is the outcome of a bet, or of some of the legs of a multi.
+type Settlement struct {
+	BetID int64
+	// RaceID is the race whose result, or abandonment, settled the bet.
+	RaceID int64
+	// EventID is the sports event whose result, or abandonment, settled the
+	// bet, in place of a race.
+	EventID int64
+	// Status is WON, LOST or REFUNDED, or ACCEPTED when only legs are
+	// settled.
+	Status betting.Bet_Status
+	// Payout is the amount returned, in cents.
+	Payout int64
+	// Reason explains the outcome, such as a dead heat or scratching, for
+	// the settlement record.
+	Reason string
+	// Legs are the legs of a multi settled.
+	Legs []LegSettlement
+}
+
+// LegSettlement is the outcome of a leg of a multi.
+type LegSettlement struct {
+	// Number is the leg's position in the multi, from 1.
+	Number int
+	// Status is WON, LOST or VOID.
+	Status betting.Leg_Status
+	// Dividend is the odds the leg carries into the multi.
+	Dividend float64
+}
+
+type betsRepo struct {
+	db   *sql.DB
+	init sync.Once
+
+	// mu serialises the acceptance of bets. The limits are checked by the
+	// statement inserting each bet, but SQLite may fail rather than wait when
+	// two such statements upgrade their locks at once.
+	mu sync.Mutex
+}
+
+// NewBetsRepo creates a new bets repository.
+func NewBetsRepo(db *sql.DB) BetsRepo {
+	return &betsRepo{db: db}
+}
+
+// Init migrates the database schema up to date.
+func (r *betsRepo) Init(ctx context.Context) error {
+	var err error
+
+	r.init.Do(func() {
+		err = migrate(ctx, r.db)
+	})
+
+	return err
+}
+
+func (r *betsRepo) Get(ctx context.Context, id int64) (*betting.Bet, error) {
+	rows, err := r.db.QueryContext(ctx, getBetQueries()[betsGet], id)
+	if err != nil {
+		return nil, err
+	}
+
+	bets, err := r.scanBets(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bets) == 0 {
+		return nil, ErrBetNotFound
+	}
+
+	return bets[0], nil
+}
+
+func (r *betsRepo) List(ctx context.Context, in *betting.ListBetsRequest) ([]*betting.Bet, string, error) {
+	var (
+		clauses []string
+		args    []interface{}
+	)
+
+	filter := in.Filter
+	if filter == nil {
+		filter = &betting.ListBetsRequestFilter{}
+	}
+
+	if filter.RaceId != 0 {
+		clauses = append(clauses, "(race_id = ? OR id IN (SELECT bet_id FROM legs WHERE race_id = ?))")
+		args = append(args, filter.RaceId, filter.RaceId)
+	}
+
+	if filter.RunnerId != 0 {
+		clauses = append(clauses, "(runner_id = ? OR id IN (SELECT bet_id FROM legs WHERE runner_id = ?))")
+		args = append(args, filter.RunnerId, filter.RunnerId)
+	}
+
+	if filter.EventId != 0 {
+		clauses = append(clauses, "id IN (SELECT bet_id FROM legs WHERE event_id = ?)")
+		args = append(args, filter.EventId)
+	}
+
+	if filter.AccountId != 0 {
+		clauses = append(clauses, "account_id = ?")
+		args = append(args, filter.AccountId)
+	}
+
+	if filter.Status != betting.Bet_STATUS_UNSPECIFIED {
+		clauses = append(clauses, "status = ?")
+		args = append(args, filter.Status.String())
+	}
+
+	if in.PageToken != "" {
+		after, err := decodePageToken(in.PageToken, filter)
+		if err != nil {
+			return nil, "", err
+		}
+
+		clauses = append(clauses, "id < ?")
+		args = append(args, after)
+	}
+
+	query := getBetQueries()[betsList]
+	if len(clauses) > 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+
+	// One bet more than a page is fetched, to tell whether another page follows.
+	size := pageSize(in.PageSize)
+	query += " ORDER BY id DESC LIMIT ?"
+	args = append(args, size+1)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	bets, err := r.scanBets(ctx, rows)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(bets) <= size {
+		return bets, "", nil
+	}
+
+	bets = bets[:size]
+
+	next, err := encodePageToken(filter, bets[size-1].Id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return bets, next, nil
+}
+
+func (r *betsRepo) Create(ctx context.Context, bet *betting.Bet, limits LiabilityLimits) (*betting.Bet, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	liability := bet.PotentialReturn - bet.Stake
+	accepted := betting.Bet_ACCEPTED.String()
+
+	created := proto.Clone(bet).(*betting.Bet)
+	created.Status = betting.Bet_ACCEPTED
+
+	// The runners and races, and sports events, the bet depends on.
+	exposures := []*betting.Leg{{RaceId: bet.RaceId, RunnerId: bet.RunnerId}}
+	if len(bet.Legs) > 0 {
+		exposures = bet.Legs
+	}
+
+	if err := inTx(ctx, r.db, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, getBetQueries()[betsCreate],
+			bet.RaceId,
+			bet.RunnerId,
+			bet.Type.String(),
+			bet.Stake,
+			bet.Odds,
+			bet.Places,
+			bet.PotentialReturn,
+			liability,
+			accepted,
+			now.Format(time.RFC3339),
+			bet.AccountId,
+			bet.StakeReference,
+		)
+		if err != nil {
+			return err
+		}
+
+		if created.Id, err = result.LastInsertId(); err != nil {
+			return err
+		}
+
+		// The bet's own liability is recorded first, and so is included in
+		// the sums the limits are checked against.
+		for _, exposure := range exposures {
+			if _, err := tx.ExecContext(ctx, getBetQueries()[exposuresCreate], created.Id, exposure.RaceId, exposure.RunnerId, exposure.EventId, liability); err != nil {
+				return err
+			}
+		}
+
+		for _, exposure := range exposures {
+			if exposure.EventId != 0 {
+				var event int64
+
+				if err := tx.QueryRowContext(ctx, getBetQueries()[eventsExposure], exposure.EventId, accepted).Scan(&event); err != nil {
+					return err
+				}
+
+				if event > limits.Event {
+					return fmt.Errorf("%w: event %d has %d cents of liability, and a limit of %d", ErrLiabilityExceeded, exposure.EventId, event-liability, limits.Event)
+				}
+
+				continue
+			}
+
+			var runner, race int64
+
+			if err := tx.QueryRowContext(ctx, getBetQueries()[betsExposure], exposure.RunnerId, accepted, exposure.RaceId, accepted).Scan(&runner, &race); err != nil {
+				return err
+			}
+
+			if runner > limits.Runner {
+				return fmt.Errorf("%w: runner %d has %d cents of liability, and a limit of %d", ErrLiabilityExceeded, exposure.RunnerId, runner-liability, limits.Runner)
+			}
+
+			if race > limits.Race {
+				return fmt.Errorf("%w: race %d has %d cents of liability, and a limit of %d", ErrLiabilityExceeded, exposure.RaceId, race-liability, limits.Race)
+			}
+		}
+
+		for i, leg := range created.Legs {
+			leg.Status = betting.Leg_PENDING
+
+			if _, err := tx.ExecContext(ctx, getBetQueries()[legsCreate],
+				created.Id,
+				i+1,
+				leg.RaceId,
+				leg.RunnerId,
+				leg.Type.String(),
+				leg.Odds,
+				leg.Places,
+				leg.Status.String(),
+				leg.SameRaceOdds,
+				leg.EventId,
+				leg.Participant,
+			); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if created.PlacedAt, err = ptypes.TimestampProto(now); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (r *betsRepo) ListOpen(ctx context.Context, raceID int64) ([]*betting.Bet, error) {
+	rows, err := r.db.QueryContext(ctx, getBetQueries()[betsOpen], raceID, betting.Bet_ACCEPTED.String(), raceID, betting.Leg_PENDING.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return r.scanBets(ctx, rows)
+}
+
+func (r *betsRepo) ListOpenOnEvent(ctx context.Context, eventID int64) ([]*betting.Bet, error) {
+	rows, err := r.db.QueryContext(ctx, getBetQueries()[betsOnEvent], eventID, betting.Leg_PENDING.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return r.scanBets(ctx, rows)
+}
+
+func (r *betsRepo) ListPendingEvents(ctx context.Context) ([]int64, error) {
+	rows, err := r.db.QueryContext(ctx, getBetQueries()[legsEvents], betting.Leg_PENDING.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []int64
+
+	for rows.Next() {
+		var eventID int64
+		if err := rows.Scan(&eventID); err != nil {
+			return nil, err
+		}
+
+		events = append(events, eventID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r *betsRepo) Settle(ctx context.Context, settlements []Settlement) ([]*betting.Bet, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	var changed []int64
+
+	if err := inTx(ctx, r.db, func(tx *sql.Tx) error {
+		for _, settlement := range settlements {
+			legsSettled := false
+
+			for _, leg := range settlement.Legs {
+				result, err := tx.ExecContext(ctx, getBetQueries()[legsSettle],
+					leg.Status.String(),
+					leg.Dividend,
+					settlement.BetID,
+					leg.Number,
+					betting.Leg_PENDING.String(),
+				)
+				if err != nil {
+					return err
+				}
+
+				if updated, err := result.RowsAffected(); err != nil {
+					return err
+				} else if updated > 0 {
+					legsSettled = true
+				}
+			}
+
+			betSettled, err := settleBet(ctx, tx, settlement, now)
+			if err != nil {
+				return err
+			}
+
+			if legsSettled || betSettled {
+				changed = append(changed, settlement.BetID)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	bets := make([]*betting.Bet, 0, len(changed))
+
+	for _, id := range changed {
+		bet, err := r.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		bets = append(bets, bet)
+	}
+
+	sort.Slice(bets, func(i, j int) bool { return bets[i].Id < bets[j].Id })
+
+	return bets, nil
+}
+
+func (r *betsRepo) ListUnpaid(ctx context.Context) ([]Payment, error) {
+	rows, err := r.db.QueryContext(ctx, getBetQueries()[settlementsUnpaid])
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var payments []Payment
+
+	for rows.Next() {
+		var (
+			payment Payment
+			status  string
+		)
+
+		if err := rows.Scan(&payment.BetID, &payment.AccountID, &payment.StakeReference, &status, &payment.Payout); err != nil {
+			return nil, err
+		}
+
+		payment.Status = betting.Bet_Status(betting.Bet_Status_value[status])
+
+		payments = append(payments, payment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+func (r *betsRepo) MarkPaid(ctx context.Context, betID int64) error {
+	_, err := r.db.ExecContext(ctx, getBetQueries()[settlementsPaid], time.Now().UTC().Format(time.RFC3339), betID)
+
+	return err
+}
+
+func (r *betsRepo) MarkPaymentFailed(ctx context.Context, betID int64, reason string) error {
+	_, err := r.db.ExecContext(ctx, getBetQueries()[settlementsFailed], time.Now().UTC().Format(time.RFC3339), reason, betID)
+
+	return err
+}
+
+// settleBet settles a bet still ACCEPTED, recording its settlement, and
+// reports whether it did.
+func settleBet(ctx context.Context, tx *sql.Tx, settlement Settlement, now time.Time) (bool, error) {
+	if settlement.Status == betting.Bet_ACCEPTED {
+		return false, nil
+	}
+
+	result, err := tx.ExecContext(ctx, getBetQueries()[betsSettle],
+		settlement.Status.String(),
+		settlement.Payout,
+		now.Format(time.RFC3339),
+		settlement.BetID,
+		betting.Bet_ACCEPTED.String(),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	if updated, err := result.RowsAffected(); err != nil || updated == 0 {
+		return false, err
+	}
+
+	if _, err := tx.ExecContext(ctx, getBetQueries()[settlementsCreate],
+		settlement.BetID,
+		settlement.RaceID,
+		settlement.EventID,
+		settlement.Status.String(),
+		settlement.Payout,
+		settlement.Reason,
+		now.Format(time.RFC3339),
+	); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// scanBets scans bets, then loads the legs of any multis among them.
+func (r *betsRepo) scanBets(ctx context.Context, rows *sql.Rows) ([]*betting.Bet, error) {
+	bets, err := scanBets(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	multis := make(map[int64]*betting.Bet)
+
+	var (
+		placeholders []string
+		args         []interface{}
+	)
+
+	for _, bet := range bets {
+		if bet.Type == betting.Bet_MULTI {
+			multis[bet.Id] = bet
+			placeholders = append(placeholders, "?")
+			args = append(args, bet.Id)
+		}
+	}
+
+	if len(multis) == 0 {
+		return bets, nil
+	}
+
+	legRows, err := r.db.QueryContext(ctx,
+		getBetQueries()[legsList]+" WHERE bet_id IN ("+strings.Join(placeholders, ",")+") ORDER BY bet_id, number",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer legRows.Close()
+
+	for legRows.Next() {
+		var (
+			leg             betting.Leg
+			betID           int64
+			legType, status string
+		)
+
+		if err := legRows.Scan(&betID, &leg.RaceId, &leg.RunnerId, &legType, &leg.Odds, &leg.Places, &status, &leg.Dividend, &leg.SameRaceOdds, &leg.EventId, &leg.Participant); err != nil {
+			return nil, err
+		}
+
+		leg.Type = betting.Bet_Type(betting.Bet_Type_value[legType])
+		leg.Status = betting.Leg_Status(betting.Leg_Status_value[status])
+
+		multis[betID].Legs = append(multis[betID].Legs, &leg)
+	}
+
+	if err := legRows.Err(); err != nil {
+		return nil, err
+	}
+
+	return bets, nil
+}
+
+func scanBets(rows *sql.Rows) ([]*betting.Bet, error) {
+	defer rows.Close()
+
+	var bets []*betting.Bet
+
+	for rows.Next() {
+		var (
+			bet             betting.Bet
+			betType, status string
+			placedAt        time.Time
+			settledAt       sql.NullTime
+		)
+
+		if err := rows.Scan(
+			&bet.Id,
+			&bet.RaceId,
+			&bet.RunnerId,
+			&betType,
+			&bet.Stake,
+			&bet.Odds,
+			&bet.Places,
+			&bet.PotentialReturn,
+			&status,
+			&placedAt,
+			&bet.Payout,
+			&settledAt,
+			&bet.AccountId,
+			&bet.StakeReference,
+		); err != nil {
+			return nil, err
+		}
+
+		bet.Type = betting.Bet_Type(betting.Bet_Type_value[betType])
+		bet.Status = betting.Bet_Status(betting.Bet_Status_value[status])
+
+		ts, err := ptypes.TimestampProto(placedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		bet.PlacedAt = ts
+
+		if settledAt.Valid {
+			if bet.SettledAt, err = ptypes.TimestampProto(settledAt.Time); err != nil {
+				return nil, err
+			}
+		}
+
+		bets = append(bets, &bet)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return bets, nil
+}
+
+// pageSize returns the effective page size for a requested size.
+func pageSize(requested int32) int {
+	switch {
+	case requested <= 0:
+		return defaultPageSize
+	case requested > maxPageSize:
+		return maxPageSize
+	default:
+		return int(requested)
+	}
+}
+
+// pageToken is the wire form of a page token: the ID of the last bet of the
+// previous page, and the filter it was issued for.
+type pageToken struct {
+	Filter []byte `json:"f"`
+	After  int64  `json:"a"`
+}
+
+// encodePageToken returns an opaque token positioned after the given bet.
+func encodePageToken(filter *betting.ListBetsRequestFilter, after int64) (string, error) {
+	fingerprint, err := proto.MarshalOptions{Deterministic: true}.Marshal(filter)
+	if err != nil {
+		return "", err
+	}
+
+	encoded, err := json.Marshal(pageToken{Filter: fingerprint, After: after})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(encoded), nil
+}
+
+// decodePageToken decodes a token previously returned by encodePageToken,
+// checking it was issued for the same filter, and returns the ID the next
+// page starts after.
+func decodePageToken(encoded string, filter *betting.ListBetsRequestFilter) (int64, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return 0, fmt.Errorf("%w: malformed token", ErrInvalidPageToken)
+	}
+
+	var token pageToken
+	if err := json.Unmarshal(decoded, &token); err != nil {
+		return 0, fmt.Errorf("%w: malformed token", ErrInvalidPageToken)
+	}
+
+	issued := &betting.ListBetsRequestFilter{}
+	if err := proto.Unmarshal(token.Filter, issued); err != nil || !proto.Equal(issued, filter) {
+		return 0, fmt.Errorf("%w: token was issued for a different filter", ErrInvalidPageToken)
+	}
+
+	return token.After, nil
+}
@@ -0,0 +1,535 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"git.neds.sh/matty/entain/betting/proto/betting"
+)
+
+// openTestRepo opens an empty, initialised bets repository in a temporary
+// SQLite database.
+func openTestRepo(t *testing.T) BetsRepo {
+	t.Helper()
+
+	db, err := Open(filepath.Join(t.TempDir(), "betting.db"))
+	if err != nil {
+		t.Fatalf("opening database: %s", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	repo := NewBetsRepo(db)
+	if err := repo.Init(context.Background()); err != nil {
+		t.Fatalf("initialising repository: %s", err)
+	}
+
+	return repo
+}
+
+// testBet returns a win bet of stake cents at odds of 3, so with a liability
+// of twice its stake.
+func testBet(raceID, runnerID, stake int64) *betting.Bet {
+	return &betting.Bet{
+		RaceId:          raceID,
+		RunnerId:        runnerID,
+		Type:            betting.Bet_WIN,
+		Stake:           stake,
+		Odds:            3,
+		Places:          1,
+		PotentialReturn: stake * 3,
+	}
+}
+
+var noLimits = LiabilityLimits{Runner: 1 << 62, Race: 1 << 62, Event: 1 << 62}
+
+func TestBetsRepoCreateAndGet(t *testing.T) {
+	ctx := context.Background()
+	repo := openTestRepo(t)
+
+	created, err := repo.Create(ctx, testBet(1, 10, 500), noLimits)
+	if err != nil {
+		t.Fatalf("creating bet: %s", err)
+	}
+
+	if created.Id == 0 || created.Status != betting.Bet_ACCEPTED || created.PlacedAt == nil {
+		t.Fatalf("created bet = %v, want an ID, ACCEPTED status and placement time", created)
+	}
+
+	got, err := repo.Get(ctx, created.Id)
+	if err != nil {
+		t.Fatalf("getting bet: %s", err)
+	}
+
+	if got.RunnerId != 10 || got.Stake != 500 || got.Odds != 3 || got.PotentialReturn != 1500 ||
+		!got.PlacedAt.AsTime().Equal(created.PlacedAt.AsTime()) {
+		t.Errorf("got bet %v, want %v", got, created)
+	}
+
+	if _, err := repo.Get(ctx, created.Id+1); !errors.Is(err, ErrBetNotFound) {
+		t.Errorf("getting missing bet: err = %v, want ErrBetNotFound", err)
+	}
+}
+
+func TestBetsRepoLiabilityLimits(t *testing.T) {
+	ctx := context.Background()
+	repo := openTestRepo(t)
+	limits := LiabilityLimits{Runner: 1000, Race: 1500}
+
+	// Liability 1000 fills runner 10 exactly.
+	if _, err := repo.Create(ctx, testBet(1, 10, 500), limits); err != nil {
+		t.Fatalf("creating bet within limits: %s", err)
+	}
+
+	if _, err := repo.Create(ctx, testBet(1, 10, 1), limits); !errors.Is(err, ErrLiabilityExceeded) {
+		t.Errorf("exceeding runner limit: err = %v, want ErrLiabilityExceeded", err)
+	}
+
+	// Runner 11 has room, but the race only has 500 left.
+	if _, err := repo.Create(ctx, testBet(1, 11, 300), limits); !errors.Is(err, ErrLiabilityExceeded) {
+		t.Errorf("exceeding race limit: err = %v, want ErrLiabilityExceeded", err)
+	}
+
+	if _, err := repo.Create(ctx, testBet(1, 11, 250), limits); err != nil {
+		t.Errorf("creating bet filling race limit: %s", err)
+	}
+
+	// Other races are unaffected.
+	if _, err := repo.Create(ctx, testBet(2, 20, 500), limits); err != nil {
+		t.Errorf("creating bet on another race: %s", err)
+	}
+}
+
+func TestBetsRepoConcurrentLiability(t *testing.T) {
+	ctx := context.Background()
+	repo := openTestRepo(t)
+
+	// Room for exactly five bets of liability 200 on the runner.
+	limits := LiabilityLimits{Runner: 1000, Race: 1000}
+
+	var (
+		wg                sync.WaitGroup
+		mu                sync.Mutex
+		accepted, refused int
+	)
+
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := repo.Create(ctx, testBet(1, 10, 100), limits)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			switch {
+			case err == nil:
+				accepted++
+			case errors.Is(err, ErrLiabilityExceeded):
+				refused++
+			default:
+				t.Errorf("creating bet: %s", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if accepted != 5 || refused != 15 {
+		t.Errorf("accepted %d and refused %d bets, want 5 and 15", accepted, refused)
+	}
+}
+
+func TestBetsRepoList(t *testing.T) {
+	ctx := context.Background()
+	repo := openTestRepo(t)
+
+	for i := int64(0); i < 7; i++ {
+		if _, err := repo.Create(ctx, testBet(1+i%2, 10+i%2, 100), noLimits); err != nil {
+			t.Fatalf("creating bet: %s", err)
+		}
+	}
+
+	// Bets on race 1 have IDs 1, 3, 5 and 7, listed newest first.
+	in := &betting.ListBetsRequest{Filter: &betting.ListBetsRequestFilter{RaceId: 1}, PageSize: 3}
+
+	var ids []int64
+
+	for page := 0; ; page++ {
+		bets, next, err := repo.List(ctx, in)
+		if err != nil {
+			t.Fatalf("listing page %d: %s", page, err)
+		}
+
+		for _, bet := range bets {
+			ids = append(ids, bet.Id)
+		}
+
+		if next == "" {
+			break
+		}
+
+		in.PageToken = next
+	}
+
+	if want := []int64{7, 5, 3, 1}; !equalIDs(ids, want) {
+		t.Errorf("listed bets %v, want %v", ids, want)
+	}
+
+	// A token is only good for the filter it was issued for.
+	_, next, err := repo.List(ctx, &betting.ListBetsRequest{PageSize: 1})
+	if err != nil || next == "" {
+		t.Fatalf("listing first page: next = %q, err = %v", next, err)
+	}
+
+	if _, _, err := repo.List(ctx, &betting.ListBetsRequest{
+		Filter:    &betting.ListBetsRequestFilter{RunnerId: 10},
+		PageToken: next,
+	}); !errors.Is(err, ErrInvalidPageToken) {
+		t.Errorf("listing with another filter's token: err = %v, want ErrInvalidPageToken", err)
+	}
+
+	if _, _, err := repo.List(ctx, &betting.ListBetsRequest{PageToken: "not a token"}); !errors.Is(err, ErrInvalidPageToken) {
+		t.Errorf("listing with malformed token: err = %v, want ErrInvalidPageToken", err)
+	}
+}
+
+func equalIDs(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestBetsRepoSettle(t *testing.T) {
+	ctx := context.Background()
+	repo := openTestRepo(t)
+	limits := LiabilityLimits{Runner: 1000, Race: 1000}
+
+	won, err := repo.Create(ctx, testBet(1, 10, 500), limits)
+	if err != nil {
+		t.Fatalf("creating bet: %s", err)
+	}
+
+	lost, err := repo.Create(ctx, testBet(2, 20, 100), noLimits)
+	if err != nil {
+		t.Fatalf("creating bet: %s", err)
+	}
+
+	settlements := []Settlement{
+		{BetID: won.Id, Status: betting.Bet_WON, Payout: 1500, Reason: "finished 1st"},
+		{BetID: lost.Id, Status: betting.Bet_LOST, Reason: "finished 2nd"},
+	}
+
+	settled, err := repo.Settle(ctx, settlements)
+	if err != nil {
+		t.Fatalf("settling bets: %s", err)
+	}
+
+	if len(settled) != 2 || settled[0].Status != betting.Bet_WON || settled[0].Payout != 1500 ||
+		settled[0].SettledAt == nil || settled[1].Status != betting.Bet_LOST {
+		t.Fatalf("settled %v, want the won and lost bets", settled)
+	}
+
+	// Settling again, even differently, changes nothing.
+	settlements[1].Status, settlements[1].Payout = betting.Bet_WON, 300
+
+	if settled, err := repo.Settle(ctx, settlements); err != nil || len(settled) != 0 {
+		t.Errorf("settling again: settled %v, err = %v, want none", settled, err)
+	}
+
+	if got, err := repo.Get(ctx, lost.Id); err != nil || got.Status != betting.Bet_LOST || got.Payout != 0 {
+		t.Errorf("got bet %v (err = %v) after settling again, want it still LOST", got, err)
+	}
+
+	// Settled bets no longer count against the liability limits.
+	if _, err := repo.Create(ctx, testBet(1, 10, 500), limits); err != nil {
+		t.Errorf("creating bet after settling: %s", err)
+	}
+}
+
+func TestBetsRepoMulti(t *testing.T) {
+	ctx := context.Background()
+	repo := openTestRepo(t)
+	limits := LiabilityLimits{Runner: 1000, Race: 1000}
+
+	// Odds of 2 and 3 make 6, so a liability of 500 on each leg.
+	multi := &betting.Bet{
+		Type:            betting.Bet_MULTI,
+		Stake:           100,
+		Odds:            6,
+		PotentialReturn: 600,
+		Legs: []*betting.Leg{
+			{RaceId: 1, RunnerId: 10, Type: betting.Bet_WIN, Odds: 2, Places: 1},
+			{RaceId: 2, RunnerId: 20, Type: betting.Bet_PLACE, Odds: 3, Places: 3},
+		},
+	}
+
+	created, err := repo.Create(ctx, multi, limits)
+	if err != nil {
+		t.Fatalf("creating multi: %s", err)
+	}
+
+	got, err := repo.Get(ctx, created.Id)
+	if err != nil {
+		t.Fatalf("getting multi: %s", err)
+	}
+
+	if len(got.Legs) != 2 || got.Legs[1].RaceId != 2 || got.Legs[1].Type != betting.Bet_PLACE || got.Legs[1].Status != betting.Leg_PENDING {
+		t.Fatalf("got multi legs %v, want both legs PENDING, in order", got.Legs)
+	}
+
+	// The multi is listed by the races and runners of its legs.
+	for _, filter := range []*betting.ListBetsRequestFilter{{RaceId: 2}, {RunnerId: 10}} {
+		if bets, _, err := repo.List(ctx, &betting.ListBetsRequest{Filter: filter}); err != nil || len(bets) != 1 || len(bets[0].Legs) != 2 {
+			t.Errorf("listing bets by %v: got %v (err = %v), want the multi with its legs", filter, bets, err)
+		}
+	}
+
+	// Its liability counts against the runner of each leg.
+	if _, err := repo.Create(ctx, testBet(2, 20, 251), limits); !errors.Is(err, ErrLiabilityExceeded) {
+		t.Errorf("exceeding runner limit of a leg: err = %v, want ErrLiabilityExceeded", err)
+	}
+
+	// Settling the first leg leaves the multi open on the second race.
+	settlement := Settlement{
+		BetID:  created.Id,
+		RaceID: 1,
+		Status: betting.Bet_ACCEPTED,
+		Legs:   []LegSettlement{{Number: 1, Status: betting.Leg_WON, Dividend: 2}},
+	}
+
+	if settled, err := repo.Settle(ctx, []Settlement{settlement}); err != nil || len(settled) != 1 ||
+		settled[0].Status != betting.Bet_ACCEPTED || settled[0].Legs[0].Status != betting.Leg_WON || settled[0].Legs[0].Dividend != 2 {
+		t.Fatalf("settling first leg: settled %v, err = %v, want the leg WON and the multi ACCEPTED", settled, err)
+	}
+
+	if settled, err := repo.Settle(ctx, []Settlement{settlement}); err != nil || len(settled) != 0 {
+		t.Errorf("settling first leg again: settled %v, err = %v, want none", settled, err)
+	}
+
+	if open, err := repo.ListOpen(ctx, 1); err != nil || len(open) != 0 {
+		t.Errorf("open bets on first race: got %v (err = %v), want none", open, err)
+	}
+
+	if open, err := repo.ListOpen(ctx, 2); err != nil || len(open) != 1 {
+		t.Errorf("open bets on second race: got %v (err = %v), want the multi", open, err)
+	}
+}
+
+func TestBetsRepoPayments(t *testing.T) {
+	ctx := context.Background()
+	repo := openTestRepo(t)
+
+	// A bet placed from an account, and one placed before accounts were.
+	staked := testBet(1, 10, 500)
+	staked.AccountId, staked.StakeReference = 7, "bet-a"
+
+	placed, err := repo.Create(ctx, staked, noLimits)
+	if err != nil {
+		t.Fatalf("creating bet: %s", err)
+	}
+
+	unstaked, err := repo.Create(ctx, testBet(1, 11, 500), noLimits)
+	if err != nil {
+		t.Fatalf("creating bet: %s", err)
+	}
+
+	bets, _, err := repo.List(ctx, &betting.ListBetsRequest{Filter: &betting.ListBetsRequestFilter{AccountId: 7}})
+	if err != nil || len(bets) != 1 || bets[0].Id != placed.Id || bets[0].StakeReference != "bet-a" {
+		t.Errorf("listing the account's bets: got %v, %v, want bet %d", bets, err, placed.Id)
+	}
+
+	if _, err := repo.Settle(ctx, []Settlement{
+		{BetID: placed.Id, Status: betting.Bet_REFUNDED, Payout: 500, Reason: "race abandoned"},
+		{BetID: unstaked.Id, Status: betting.Bet_REFUNDED, Payout: 500, Reason: "race abandoned"},
+	}); err != nil {
+		t.Fatalf("settling bets: %s", err)
+	}
+
+	payments, err := repo.ListUnpaid(ctx)
+	if err != nil {
+		t.Fatalf("listing unpaid: %s", err)
+	}
+
+	want := Payment{BetID: placed.Id, AccountID: 7, StakeReference: "bet-a", Status: betting.Bet_REFUNDED, Payout: 500}
+	if len(payments) != 1 || payments[0] != want {
+		t.Fatalf("unpaid %v, want only %v", payments, want)
+	}
+
+	if err := repo.MarkPaid(ctx, placed.Id); err != nil {
+		t.Fatalf("marking paid: %s", err)
+	}
+
+	if payments, err := repo.ListUnpaid(ctx); err != nil || len(payments) != 0 {
+		t.Errorf("unpaid after paying: %v, %v, want none", payments, err)
+	}
+}
+
+func TestBetsRepoSameRaceMulti(t *testing.T) {
+	ctx := context.Background()
+	repo := openTestRepo(t)
+	limits := LiabilityLimits{Runner: 1000, Race: 1000}
+
+	// Two legs on race 1, priced together at 6, so a liability of 500 that
+	// counts once against the race.
+	multi := &betting.Bet{
+		Type:            betting.Bet_MULTI,
+		Stake:           100,
+		Odds:            6,
+		PotentialReturn: 600,
+		Legs: []*betting.Leg{
+			{RaceId: 1, RunnerId: 10, Type: betting.Bet_WIN, Odds: 4, Places: 1, SameRaceOdds: 6},
+			{RaceId: 1, RunnerId: 11, Type: betting.Bet_PLACE, Odds: 2, Places: 3, SameRaceOdds: 6},
+		},
+	}
+
+	created, err := repo.Create(ctx, multi, limits)
+	if err != nil {
+		t.Fatalf("creating multi: %s", err)
+	}
+
+	got, err := repo.Get(ctx, created.Id)
+	if err != nil {
+		t.Fatalf("getting multi: %s", err)
+	}
+
+	if len(got.Legs) != 2 || got.Legs[0].SameRaceOdds != 6 || got.Legs[1].SameRaceOdds != 6 {
+		t.Fatalf("got multi legs %v, want both priced together at 6", got.Legs)
+	}
+
+	if _, err := repo.Create(ctx, testBet(1, 12, 250), limits); err != nil {
+		t.Errorf("taking the race to its limit: %s", err)
+	}
+
+	if _, err := repo.Create(ctx, testBet(1, 13, 1), limits); !errors.Is(err, ErrLiabilityExceeded) {
+		t.Errorf("exceeding race limit: err = %v, want ErrLiabilityExceeded", err)
+	}
+
+	// Each leg on the race is settled on its own.
+	settlement := Settlement{
+		BetID:  created.Id,
+		RaceID: 1,
+		Status: betting.Bet_LOST,
+		Legs: []LegSettlement{
+			{Number: 1, Status: betting.Leg_WON, Dividend: 4},
+			{Number: 2, Status: betting.Leg_LOST},
+		},
+	}
+
+	settled, err := repo.Settle(ctx, []Settlement{settlement})
+	if err != nil || len(settled) != 1 || settled[0].Status != betting.Bet_LOST ||
+		settled[0].Legs[0].Status != betting.Leg_WON || settled[0].Legs[1].Status != betting.Leg_LOST {
+		t.Errorf("settling race: settled %v, err = %v, want the first leg WON, the second LOST and the multi LOST", settled, err)
+	}
+}
+
+func TestBetsRepoEventLegs(t *testing.T) {
+	ctx := context.Background()
+	repo := openTestRepo(t)
+	limits := LiabilityLimits{Runner: 1000, Race: 1000, Event: 1000}
+
+	// A leg on race 1 and legs on events 5 and 6, at odds of 2, 1.5 and 2, so
+	// 6 together and a liability of 500 counting against race 1 and each
+	// event.
+	multi := &betting.Bet{
+		Type:            betting.Bet_MULTI,
+		Stake:           100,
+		Odds:            6,
+		PotentialReturn: 600,
+		Legs: []*betting.Leg{
+			{RaceId: 1, RunnerId: 10, Type: betting.Bet_WIN, Odds: 2, Places: 1, SameRaceOdds: 2},
+			{EventId: 5, Participant: "Lakers", Type: betting.Bet_WIN, Odds: 1.5, Places: 1, SameRaceOdds: 1.5},
+			{EventId: 6, Participant: "Ash Barty", Type: betting.Bet_WIN, Odds: 2, Places: 1, SameRaceOdds: 2},
+		},
+	}
+
+	created, err := repo.Create(ctx, multi, limits)
+	if err != nil {
+		t.Fatalf("creating multi: %s", err)
+	}
+
+	got, err := repo.Get(ctx, created.Id)
+	if err != nil {
+		t.Fatalf("getting multi: %s", err)
+	}
+
+	if len(got.Legs) != 3 || got.Legs[1].EventId != 5 || got.Legs[1].Participant != "Lakers" || got.Legs[1].RaceId != 0 {
+		t.Fatalf("got multi legs %v, want the second on Lakers in event 5", got.Legs)
+	}
+
+	// Legs on events count against no runner.
+	if _, err := repo.Create(ctx, testBet(2, 0, 500), limits); err != nil {
+		t.Errorf("creating bet alongside the event legs: %s", err)
+	}
+
+	if bets, _, err := repo.List(ctx, &betting.ListBetsRequest{Filter: &betting.ListBetsRequestFilter{EventId: 6}}); err != nil || len(bets) != 1 || bets[0].Id != created.Id {
+		t.Errorf("listing bets on event 6: got %v (err = %v), want the multi", bets, err)
+	}
+
+	if events, err := repo.ListPendingEvents(ctx); err != nil || len(events) != 2 || events[0] != 5 || events[1] != 6 {
+		t.Errorf("pending events: got %v (err = %v), want 5 and 6", events, err)
+	}
+
+	// Settling the leg on event 5 leaves only event 6 pending.
+	settlement := Settlement{
+		BetID:   created.Id,
+		EventID: 5,
+		Status:  betting.Bet_ACCEPTED,
+		Legs:    []LegSettlement{{Number: 2, Status: betting.Leg_WON, Dividend: 1.5}},
+	}
+
+	if settled, err := repo.Settle(ctx, []Settlement{settlement}); err != nil || len(settled) != 1 || settled[0].Legs[1].Status != betting.Leg_WON {
+		t.Fatalf("settling event leg: settled %v, err = %v, want the second leg WON", settled, err)
+	}
+
+	if open, err := repo.ListOpenOnEvent(ctx, 5); err != nil || len(open) != 0 {
+		t.Errorf("open bets on event 5: got %v (err = %v), want none", open, err)
+	}
+
+	if open, err := repo.ListOpenOnEvent(ctx, 6); err != nil || len(open) != 1 || open[0].Id != created.Id {
+		t.Errorf("open bets on event 6: got %v (err = %v), want the multi", open, err)
+	}
+
+	if events, err := repo.ListPendingEvents(ctx); err != nil || len(events) != 1 || events[0] != 6 {
+		t.Errorf("pending events after settling event 5: got %v (err = %v), want 6", events, err)
+	}
+
+	// A multi of legs on events 6 and 9 alone counts against both events,
+	// so takes event 6 to its limit, and no further.
+	eventsOnly := func(stake int64) *betting.Bet {
+		return &betting.Bet{
+			Type:            betting.Bet_MULTI,
+			Stake:           stake,
+			Odds:            2,
+			PotentialReturn: stake * 2,
+			Legs: []*betting.Leg{
+				{EventId: 6, Participant: "Naomi Osaka", Type: betting.Bet_WIN, Odds: 1.25, Places: 1, SameRaceOdds: 1.25},
+				{EventId: 9, Participant: "Sydney FC", Type: betting.Bet_WIN, Odds: 1.6, Places: 1, SameRaceOdds: 1.6},
+			},
+		}
+	}
+
+	if _, err := repo.Create(ctx, eventsOnly(501), limits); !errors.Is(err, ErrLiabilityExceeded) {
+		t.Errorf("exceeding event limit: err = %v, want ErrLiabilityExceeded", err)
+	}
+
+	if _, err := repo.Create(ctx, eventsOnly(500), limits); err != nil {
+		t.Errorf("taking event 6 to its limit: %s", err)
+	}
+
+	if _, err := repo.Create(ctx, eventsOnly(1), limits); !errors.Is(err, ErrLiabilityExceeded) {
+		t.Errorf("exceeding event limit once reached: err = %v, want ErrLiabilityExceeded", err)
+	}
+}
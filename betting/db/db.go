@@ -0,0 +1,22 @@
+package db
+
+import (
+	"database/sql"
+	"strings"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Open opens the SQLite database at path. Writers wait for each other rather
+// than failing while the database is locked, as every bet placed is a write.
+func Open(path string) (*sql.DB, error) {
+	if !strings.Contains(path, "_busy_timeout=") {
+		if strings.Contains(path, "?") {
+			path += "&_busy_timeout=5000"
+		} else {
+			path += "?_busy_timeout=5000"
+		}
+	}
+
+	return sql.Open("sqlite3", path)
+}
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS bets (
+	id INTEGER PRIMARY KEY,
+	race_id INTEGER NOT NULL,
+	runner_id INTEGER NOT NULL,
+	type TEXT NOT NULL,
+	stake INTEGER NOT NULL,
+	odds REAL NOT NULL,
+	places INTEGER NOT NULL,
+	potential_return INTEGER NOT NULL,
+	liability INTEGER NOT NULL,
+	status TEXT NOT NULL,
+	placed_at DATETIME NOT NULL
+);
+
+CREATE INDEX bets_race_id ON bets(race_id);
+CREATE INDEX bets_runner_id ON bets(runner_id);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS settlements;
+DROP INDEX IF EXISTS bets_race_id_status;
+
+ALTER TABLE bets DROP COLUMN settled_at;
+ALTER TABLE bets DROP COLUMN payout;
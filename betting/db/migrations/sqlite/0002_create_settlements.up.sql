@@ -0,0 +1,14 @@
+ALTER TABLE bets ADD COLUMN payout INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE bets ADD COLUMN settled_at DATETIME;
+
+CREATE TABLE IF NOT EXISTS settlements (
+	id INTEGER PRIMARY KEY,
+	bet_id INTEGER NOT NULL UNIQUE REFERENCES bets(id),
+	race_id INTEGER NOT NULL,
+	status TEXT NOT NULL,
+	payout INTEGER NOT NULL,
+	reason TEXT NOT NULL,
+	settled_at DATETIME NOT NULL
+);
+
+CREATE INDEX bets_race_id_status ON bets(race_id, status);
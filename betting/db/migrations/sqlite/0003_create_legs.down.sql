@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS exposures;
+DROP TABLE IF EXISTS legs;
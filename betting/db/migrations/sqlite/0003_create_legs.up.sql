@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS legs (
+	id INTEGER PRIMARY KEY,
+	bet_id INTEGER NOT NULL REFERENCES bets(id),
+	number INTEGER NOT NULL,
+	race_id INTEGER NOT NULL,
+	runner_id INTEGER NOT NULL,
+	type TEXT NOT NULL,
+	odds REAL NOT NULL,
+	places INTEGER NOT NULL,
+	status TEXT NOT NULL,
+	dividend REAL NOT NULL DEFAULT 0,
+	UNIQUE (bet_id, number)
+);
+
+CREATE INDEX legs_race_id ON legs(race_id);
+CREATE INDEX legs_runner_id ON legs(runner_id);
+
+-- Exposures spread the liability of each bet over the runners and races it
+-- depends on: one for a single bet, and one for each leg of a multi.
+CREATE TABLE IF NOT EXISTS exposures (
+	bet_id INTEGER NOT NULL REFERENCES bets(id),
+	race_id INTEGER NOT NULL,
+	runner_id INTEGER NOT NULL,
+	liability INTEGER NOT NULL
+);
+
+CREATE INDEX exposures_race_id ON exposures(race_id);
+CREATE INDEX exposures_runner_id ON exposures(runner_id);
+
+INSERT INTO exposures(bet_id, race_id, runner_id, liability)
+SELECT id, race_id, runner_id, liability FROM bets;
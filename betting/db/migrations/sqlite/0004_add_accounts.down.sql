@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS settlements_paid_at;
+DROP INDEX IF EXISTS bets_account_id;
+
+ALTER TABLE settlements DROP COLUMN paid_at;
+ALTER TABLE bets DROP COLUMN stake_reference;
+ALTER TABLE bets DROP COLUMN account_id;
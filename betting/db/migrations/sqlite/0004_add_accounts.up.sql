@@ -0,0 +1,10 @@
+ALTER TABLE bets ADD COLUMN account_id INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE bets ADD COLUMN stake_reference TEXT NOT NULL DEFAULT '';
+
+-- Settlements are paid to accounts after they are made, so a payment that
+-- fails is retried rather than lost. Bets placed before accounts were have
+-- nothing to pay.
+ALTER TABLE settlements ADD COLUMN paid_at DATETIME;
+
+CREATE INDEX bets_account_id ON bets(account_id);
+CREATE INDEX settlements_paid_at ON settlements(paid_at);
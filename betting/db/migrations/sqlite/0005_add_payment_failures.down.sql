@@ -0,0 +1,2 @@
+ALTER TABLE settlements DROP COLUMN payment_error;
+ALTER TABLE settlements DROP COLUMN payment_failed_at;
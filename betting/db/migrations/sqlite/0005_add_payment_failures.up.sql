@@ -0,0 +1,5 @@
+-- A payment the accounts service refuses outright, rather than one that
+-- fails while it is unavailable, is recorded against its settlement and
+-- not retried, so it does not hold up the payments made after it.
+ALTER TABLE settlements ADD COLUMN payment_failed_at DATETIME;
+ALTER TABLE settlements ADD COLUMN payment_error TEXT NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE legs DROP COLUMN same_race_odds;
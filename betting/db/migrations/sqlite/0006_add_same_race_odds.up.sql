@@ -0,0 +1,5 @@
+-- Legs on the same race are priced together. Every leg placed before they
+-- could be was alone on its race, so was priced at its own odds.
+ALTER TABLE legs ADD COLUMN same_race_odds REAL NOT NULL DEFAULT 0;
+
+UPDATE legs SET same_race_odds = odds;
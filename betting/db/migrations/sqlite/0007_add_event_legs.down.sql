@@ -0,0 +1,6 @@
+ALTER TABLE settlements DROP COLUMN event_id;
+
+DROP INDEX legs_event_id;
+
+ALTER TABLE legs DROP COLUMN participant;
+ALTER TABLE legs DROP COLUMN event_id;
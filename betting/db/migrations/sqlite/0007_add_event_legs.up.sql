@@ -0,0 +1,8 @@
+-- Multis may have legs on sports events, selecting a participant by name in
+-- place of a runner. Legs and settlements on races leave the event unset.
+ALTER TABLE legs ADD COLUMN event_id INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE legs ADD COLUMN participant TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX legs_event_id ON legs(event_id);
+
+ALTER TABLE settlements ADD COLUMN event_id INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,5 @@
+DELETE FROM exposures WHERE event_id != 0;
+
+DROP INDEX exposures_event_id;
+
+ALTER TABLE exposures DROP COLUMN event_id;
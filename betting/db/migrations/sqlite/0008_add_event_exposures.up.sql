@@ -0,0 +1,11 @@
+-- Legs on sports events spread a bet's liability over the events too, so
+-- that it counts against a limit as a race's does.
+ALTER TABLE exposures ADD COLUMN event_id INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX exposures_event_id ON exposures(event_id);
+
+INSERT INTO exposures(bet_id, race_id, runner_id, event_id, liability)
+SELECT l.bet_id, 0, 0, l.event_id, b.liability
+FROM legs l
+JOIN bets b ON b.id = l.bet_id
+WHERE l.event_id != 0;
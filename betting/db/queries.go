@@ -0,0 +1,204 @@
+package db
+
+const (
+	betsList     = "list"
+	betsGet      = "get"
+	betsCreate   = "create"
+	betsExposure = "exposure"
+	betsSettle   = "settle"
+	betsOpen     = "open"
+	betsOnEvent  = "open_on_event"
+
+	settlementsCreate = "create_settlement"
+	settlementsUnpaid = "unpaid_settlements"
+	settlementsPaid   = "paid_settlement"
+	settlementsFailed = "failed_settlement"
+	exposuresCreate   = "create_exposure"
+	eventsExposure    = "event_exposure"
+	legsCreate        = "create_leg"
+	legsList          = "list_legs"
+	legsSettle        = "settle_leg"
+	legsEvents        = "pending_events"
+)
+
+func getBetQueries() map[string]string {
+	return map[string]string{
+		betsList: `
+			SELECT 
+				id, 
+				race_id, 
+				runner_id, 
+				type, 
+				stake, 
+				odds, 
+				places, 
+				potential_return, 
+				status, 
+				placed_at, 
+				payout, 
+				settled_at, 
+				account_id, 
+				stake_reference 
+			FROM bets
+		`,
+		betsGet: `
+			SELECT 
+				id, 
+				race_id, 
+				runner_id, 
+				type, 
+				stake, 
+				odds, 
+				places, 
+				potential_return, 
+				status, 
+				placed_at, 
+				payout, 
+				settled_at, 
+				account_id, 
+				stake_reference 
+			FROM bets
+			WHERE id = ?
+		`,
+		// Bets still to be settled on a race: single bets on it yet to be
+		// settled, and multis with a leg on it yet to be, whether or not the
+		// multi itself has been.
+		betsOpen: `
+			SELECT 
+				id, 
+				race_id, 
+				runner_id, 
+				type, 
+				stake, 
+				odds, 
+				places, 
+				potential_return, 
+				status, 
+				placed_at, 
+				payout, 
+				settled_at, 
+				account_id, 
+				stake_reference 
+			FROM bets
+			WHERE (race_id = ? AND status = ?) 
+			OR id IN (SELECT bet_id FROM legs WHERE race_id = ? AND status = ?)
+			ORDER BY id
+		`,
+		// Multis with a leg on a sports event yet to be settled, whether or
+		// not the multi itself has been.
+		betsOnEvent: `
+			SELECT 
+				id, 
+				race_id, 
+				runner_id, 
+				type, 
+				stake, 
+				odds, 
+				places, 
+				potential_return, 
+				status, 
+				placed_at, 
+				payout, 
+				settled_at, 
+				account_id, 
+				stake_reference 
+			FROM bets
+			WHERE id IN (SELECT bet_id FROM legs WHERE event_id = ? AND status = ?)
+			ORDER BY id
+		`,
+		betsCreate: `
+			INSERT INTO bets(race_id, runner_id, type, stake, odds, places, potential_return, liability, status, placed_at, account_id, stake_reference) 
+			VALUES (?,?,?,?,?,?,?,?,?,?,?,?)
+		`,
+		// The open liability of a runner, and of a race, counting each bet
+		// that depends on them once, however many legs it has on the race.
+		// Exposures to sports events leave the runner and race unset.
+		betsExposure: `
+			SELECT 
+				(
+					SELECT COALESCE(SUM(e.liability), 0) 
+					FROM exposures e 
+					JOIN bets b ON b.id = e.bet_id 
+					WHERE e.runner_id = ? AND e.event_id = 0 AND b.status = ?
+				), 
+				(
+					SELECT COALESCE(SUM(b.liability), 0) 
+					FROM bets b 
+					WHERE b.id IN (SELECT bet_id FROM exposures WHERE race_id = ? AND event_id = 0) AND b.status = ?
+				)
+		`,
+		// The open liability of a sports event, counting each bet with a leg
+		// on it once.
+		eventsExposure: `
+			SELECT COALESCE(SUM(b.liability), 0) 
+			FROM bets b 
+			WHERE b.id IN (SELECT bet_id FROM exposures WHERE event_id = ?) AND b.status = ?
+		`,
+		// Only bets still open are settled, so a bet is never settled twice.
+		betsSettle: `
+			UPDATE bets SET status = ?, payout = ?, settled_at = ? 
+			WHERE id = ? AND status = ?
+		`,
+		// Only legs still pending are settled, so a leg is never settled twice.
+		legsSettle: `
+			UPDATE legs SET status = ?, dividend = ? 
+			WHERE bet_id = ? AND number = ? AND status = ?
+		`,
+		settlementsCreate: `
+			INSERT INTO settlements(bet_id, race_id, event_id, status, payout, reason, settled_at) 
+			VALUES (?,?,?,?,?,?,?)
+		`,
+		// Settlements still to be paid to the accounts of their bets, other
+		// than those whose payment was refused.
+		settlementsUnpaid: `
+			SELECT 
+				s.bet_id, 
+				b.account_id, 
+				b.stake_reference, 
+				s.status, 
+				s.payout 
+			FROM settlements s 
+			JOIN bets b ON b.id = s.bet_id 
+			WHERE s.paid_at IS NULL AND s.payment_failed_at IS NULL AND b.account_id != 0 
+			ORDER BY s.id
+		`,
+		settlementsPaid: `
+			UPDATE settlements SET paid_at = ? 
+			WHERE bet_id = ? AND paid_at IS NULL
+		`,
+		settlementsFailed: `
+			UPDATE settlements SET payment_failed_at = ?, payment_error = ? 
+			WHERE bet_id = ? AND paid_at IS NULL
+		`,
+		exposuresCreate: `
+			INSERT INTO exposures(bet_id, race_id, runner_id, event_id, liability) 
+			VALUES (?,?,?,?,?)
+		`,
+		legsCreate: `
+			INSERT INTO legs(bet_id, number, race_id, runner_id, type, odds, places, status, same_race_odds, event_id, participant) 
+			VALUES (?,?,?,?,?,?,?,?,?,?,?)
+		`,
+		legsList: `
+			SELECT 
+				bet_id, 
+				race_id, 
+				runner_id, 
+				type, 
+				odds, 
+				places, 
+				status, 
+				dividend, 
+				same_race_odds, 
+				event_id, 
+				participant 
+			FROM legs
+		`,
+		// The sports events with legs yet to be settled on them.
+		legsEvents: `
+			SELECT DISTINCT event_id 
+			FROM legs 
+			WHERE event_id != 0 AND status = ? 
+			ORDER BY event_id
+		`,
+	}
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"time"
+
+	"git.neds.sh/matty/entain/betting/db"
+	"git.neds.sh/matty/entain/betting/proto/accounts"
+	"git.neds.sh/matty/entain/betting/proto/betting"
+	"git.neds.sh/matty/entain/betting/proto/racing"
+	"git.neds.sh/matty/entain/betting/proto/sports"
+	"git.neds.sh/matty/entain/betting/service"
+	"git.neds.sh/matty/entain/betting/settlement"
+	"google.golang.org/grpc"
+)
+
+var (
+	grpcEndpoint       = flag.String("grpc-endpoint", "localhost:9002", "gRPC server endpoint")
+	racingGrpcEndpoint = flag.String("racing-grpc-endpoint", "localhost:9000", "Racing gRPC server endpoint")
+	sportsGrpcEndpoint = flag.String("sports-grpc-endpoint", "localhost:9001", "Sports gRPC server endpoint")
+	accountsEndpoint   = flag.String("accounts-grpc-endpoint", "localhost:9003", "Accounts gRPC server endpoint")
+	dbPath             = flag.String("db", "./db/betting.db", "Path of the SQLite betting database")
+	runnerLiability    = flag.Int64("runner-liability-limit", 1000000, "Maximum liability, in cents, of the open bets on a runner")
+	raceLiability      = flag.Int64("race-liability-limit", 5000000, "Maximum liability, in cents, of the open bets on a race")
+	eventLiability     = flag.Int64("event-liability-limit", 5000000, "Maximum liability, in cents, of the open bets on a sports event")
+	settleRaces        = flag.Bool("settle", true, "Settle bets as the races and sports events they are on are resulted or abandoned")
+	eventPoll          = flag.Duration("event-poll-interval", 30*time.Second, "Interval between checks for sports events resulted or abandoned")
+	paymentRetry       = flag.Duration("payment-retry-interval", time.Minute, "Interval between retries of settlements not yet paid to accounts")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [migrate up|down|status]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+
+	if flag.Arg(0) == "migrate" {
+		if err := migrate(context.Background(), flag.Arg(1)); err != nil {
+			log.Fatalf("failed migrating database: %s\n", err)
+		}
+
+		return
+	}
+
+	if flag.NArg() > 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(); err != nil {
+		log.Fatalf("failed running grpc server: %s\n", err)
+	}
+}
+
+func run() error {
+	conn, err := net.Listen("tcp", *grpcEndpoint)
+	if err != nil {
+		return err
+	}
+
+	bettingDB, err := db.Open(*dbPath)
+	if err != nil {
+		return err
+	}
+
+	betsRepo := db.NewBetsRepo(bettingDB)
+	if err := betsRepo.Init(context.Background()); err != nil {
+		return err
+	}
+
+	racingConn, err := grpc.Dial(*racingGrpcEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer racingConn.Close()
+
+	sportsConn, err := grpc.Dial(*sportsGrpcEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer sportsConn.Close()
+
+	accountsConn, err := grpc.Dial(*accountsEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer accountsConn.Close()
+
+	races := racing.NewRacingClient(racingConn)
+	events := sports.NewSportsClient(sportsConn)
+	wallet := accounts.NewAccountsClient(accountsConn)
+	settler := settlement.NewSettler(betsRepo, races, events, wallet)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go settler.RetryPayments(ctx, *paymentRetry)
+
+	if *settleRaces {
+		go settlement.NewWatcher(races, settler).Run(ctx)
+		go settler.PollEvents(ctx, *eventPoll)
+	}
+
+	grpcServer := grpc.NewServer()
+
+	betting.RegisterBettingServer(
+		grpcServer,
+		service.NewBettingService(
+			betsRepo,
+			races,
+			racing.NewPricesClient(racingConn),
+			events,
+			wallet,
+			settler,
+			db.LiabilityLimits{Runner: *runnerLiability, Race: *raceLiability, Event: *eventLiability},
+		),
+	)
+
+	log.Printf("gRPC server listening on: %s\n", *grpcEndpoint)
+
+	if err := grpcServer.Serve(conn); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// migrate runs a migrate subcommand: "up" applies pending migrations, "down"
+// reverts the latest applied migration and "status" lists every migration.
+func migrate(ctx context.Context, command string) error {
+	bettingDB, err := db.Open(*dbPath)
+	if err != nil {
+		return err
+	}
+	defer bettingDB.Close()
+
+	migrator, err := db.NewMigrator(bettingDB)
+	if err != nil {
+		return err
+	}
+
+	switch command {
+	case "up":
+		migrated, err := migrator.Up(ctx)
+		for _, migration := range migrated {
+			log.Printf("applied migration %04d_%s\n", migration.Version, migration.Name)
+		}
+
+		if err == nil && len(migrated) == 0 {
+			log.Println("no pending migrations")
+		}
+
+		return err
+	case "down":
+		migration, err := migrator.Down(ctx)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("reverted migration %04d_%s\n", migration.Version, migration.Name)
+
+		return nil
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, status := range statuses {
+			applied := "pending"
+			if status.Applied {
+				applied = "applied " + status.AppliedAt.Format(time.RFC3339)
+			}
+
+			fmt.Printf("%04d_%s\t%s\n", status.Version, status.Name, applied)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command %q, expected up, down or status", command)
+	}
+}
@@ -0,0 +1,7 @@
+package proto
+
+//go:generate protoc --go_out=. --go-grpc_out=require_unimplemented_servers=false:. betting/betting.proto
+//go:generate protoc --go_out=. --go-grpc_out=require_unimplemented_servers=false:. racing/racing.proto
+//go:generate protoc --go_out=. --go-grpc_out=require_unimplemented_servers=false:. racing/prices.proto
+//go:generate protoc --go_out=. --go-grpc_out=require_unimplemented_servers=false:. accounts/accounts.proto
+//go:generate protoc --go_out=. --go-grpc_out=require_unimplemented_servers=false:. sports/sports.proto
@@ -0,0 +1,530 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"git.neds.sh/matty/entain/betting/db"
+	"git.neds.sh/matty/entain/betting/proto/accounts"
+	"git.neds.sh/matty/entain/betting/proto/betting"
+	"git.neds.sh/matty/entain/betting/proto/racing"
+	"git.neds.sh/matty/entain/betting/proto/sports"
+	"git.neds.sh/matty/entain/betting/settlement"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Betting interface {
+	// PlaceBet will place a bet on a runner of an OPEN race, or a multi
+	// that may have legs on sports events, staked from a customer account.
+	PlaceBet(ctx context.Context, in *betting.PlaceBetRequest) (*betting.Bet, error)
+
+	// GetBet will return a single bet.
+	GetBet(ctx context.Context, in *betting.GetBetRequest) (*betting.Bet, error)
+
+	// ListBets will return a collection of bets.
+	ListBets(ctx context.Context, in *betting.ListBetsRequest) (*betting.ListBetsResponse, error)
+
+	// SettleRace will settle the open bets on a RESULTED or ABANDONED race.
+	SettleRace(ctx context.Context, in *betting.SettleRaceRequest) (*betting.SettleRaceResponse, error)
+
+	// SettleEvent will settle the legs pending on a RESULTED or ABANDONED
+	// sports event.
+	SettleEvent(ctx context.Context, in *betting.SettleEventRequest) (*betting.SettleEventResponse, error)
+}
+
+// bettingService implements the Betting interface.
+type bettingService struct {
+	betsRepo db.BetsRepo
+	races    racing.RacingClient
+	prices   racing.PricesClient
+	events   sports.SportsClient
+	wallet   accounts.AccountsClient
+	settler  *settlement.Settler
+	limits   db.LiabilityLimits
+}
+
+// NewBettingService instantiates and returns a new bettingService, taking
+// races, runners and prices from the racing service, events from the sports
+// service, and stakes from the accounts service.
+func NewBettingService(betsRepo db.BetsRepo, races racing.RacingClient, prices racing.PricesClient, events sports.SportsClient, wallet accounts.AccountsClient, settler *settlement.Settler, limits db.LiabilityLimits) Betting {
+	return &bettingService{betsRepo, races, prices, events, wallet, settler, limits}
+}
+
+func (s *bettingService) PlaceBet(ctx context.Context, in *betting.PlaceBetRequest) (*betting.Bet, error) {
+	if err := validatePlaceBetRequest(in); err != nil {
+		return nil, err
+	}
+
+	bet := &betting.Bet{Type: in.Type, Stake: in.Stake, AccountId: in.AccountId}
+
+	if in.Type == betting.Bet_MULTI {
+		var (
+			groups  []group
+			byGroup = make(map[group][]*betting.Leg)
+		)
+
+		for i, selection := range in.Legs {
+			prefix := fmt.Sprintf("legs[%d].", i)
+
+			var (
+				leg *betting.Leg
+				err error
+			)
+
+			if selection.EventId != 0 {
+				leg, err = s.priceEvent(ctx, prefix, selection)
+			} else {
+				leg, err = s.price(ctx, prefix, selection)
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			key := group{leg.RaceId, leg.EventId}
+
+			if _, ok := byGroup[key]; !ok {
+				groups = append(groups, key)
+			}
+
+			bet.Legs = append(bet.Legs, leg)
+			byGroup[key] = append(byGroup[key], leg)
+		}
+
+		// Legs alone on their race or event keep their own odds, and those on
+		// the same race are priced together.
+		bet.Odds = 1
+
+		for _, key := range groups {
+			legs := byGroup[key]
+			odds := legs[0].Odds
+
+			if len(legs) > 1 {
+				var err error
+				if odds, err = s.priceSameRace(ctx, key.raceID, legs); err != nil {
+					return nil, err
+				}
+			}
+
+			for _, leg := range legs {
+				leg.SameRaceOdds = odds
+			}
+
+			bet.Odds *= odds
+		}
+
+		bet.Odds = math.Round(bet.Odds*100) / 100
+
+		if bet.Odds > maxMultiOdds {
+			return nil, status.Errorf(codes.FailedPrecondition, "multi is priced at %.2f, more than the maximum of %d", bet.Odds, maxMultiOdds)
+		}
+
+		if in.Odds != 0 && math.Abs(in.Odds-bet.Odds) >= 0.005 {
+			return nil, status.Errorf(codes.FailedPrecondition, "multi is now priced at %.2f, not %.2f", bet.Odds, in.Odds)
+		}
+	} else {
+		leg, err := s.price(ctx, "", &betting.PlaceBetRequest_Selection{
+			RaceId:   in.RaceId,
+			RunnerId: in.RunnerId,
+			Type:     in.Type,
+			Odds:     in.Odds,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		bet.RaceId, bet.RunnerId, bet.Odds, bet.Places = leg.RaceId, leg.RunnerId, leg.Odds, leg.Places
+	}
+
+	bet.PotentialReturn = int64(math.Round(float64(bet.Stake) * bet.Odds))
+
+	// The stake is held out of the customer's balance before the bet is
+	// accepted, and returned should the bet not be.
+	var err error
+	if bet.StakeReference, err = newStakeReference(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.wallet.ReserveStake(ctx, &accounts.ReserveStakeRequest{
+		AccountId: in.AccountId,
+		Amount:    in.Stake,
+		Reference: bet.StakeReference,
+	}); err != nil {
+		// The stake may have been held even though the call failed, as when
+		// it timed out, so it is returned unless it was refused.
+		switch status.Code(err) {
+		case codes.NotFound, codes.FailedPrecondition, codes.InvalidArgument:
+		default:
+			s.refundStake(bet.StakeReference)
+		}
+
+		return nil, accountsError(err, "account %d not found", in.AccountId)
+	}
+
+	created, err := s.betsRepo.Create(ctx, bet, s.limits)
+	if err != nil {
+		s.refundStake(bet.StakeReference)
+
+		if errors.Is(err, db.ErrLiabilityExceeded) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, toStatus(err)
+	}
+
+	return created, nil
+}
+
+// refundStakeTimeout bounds the refund of a stake of a bet not accepted.
+const refundStakeTimeout = 10 * time.Second
+
+// refundStake returns the stake reserved for a bet that was not accepted,
+// if one was. It is not bound to the request, which may be why the bet was
+// not accepted.
+func (s *bettingService) refundStake(reference string) {
+	ctx, cancel := context.WithTimeout(context.Background(), refundStakeTimeout)
+	defer cancel()
+
+	_, err := s.wallet.RefundStake(ctx, &accounts.RefundStakeRequest{Reference: reference})
+	if err != nil && status.Code(err) != codes.NotFound {
+		log.Printf("refunding stake %s of a bet not accepted failed: %s\n", reference, err)
+	}
+}
+
+// newStakeReference returns a random reference for the stake of a new bet.
+func newStakeReference() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return "bet-" + hex.EncodeToString(b), nil
+}
+
+func (s *bettingService) GetBet(ctx context.Context, in *betting.GetBetRequest) (*betting.Bet, error) {
+	bet, err := s.betsRepo.Get(ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, db.ErrBetNotFound) {
+			return nil, status.Errorf(codes.NotFound, "bet %d not found", in.Id)
+		}
+
+		return nil, toStatus(err)
+	}
+
+	return bet, nil
+}
+
+func (s *bettingService) ListBets(ctx context.Context, in *betting.ListBetsRequest) (*betting.ListBetsResponse, error) {
+	if err := validateListBetsRequest(in); err != nil {
+		return nil, err
+	}
+
+	bets, next, err := s.betsRepo.List(ctx, in)
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidPageToken) {
+			return nil, invalidField("page_token", describe(err, db.ErrInvalidPageToken))
+		}
+
+		return nil, toStatus(err)
+	}
+
+	return &betting.ListBetsResponse{Bets: bets, NextPageToken: next}, nil
+}
+
+func (s *bettingService) SettleRace(ctx context.Context, in *betting.SettleRaceRequest) (*betting.SettleRaceResponse, error) {
+	if in.RaceId <= 0 {
+		return nil, invalidField("race_id", "must be positive")
+	}
+
+	race, err := s.races.GetRace(ctx, &racing.GetRaceRequest{Id: in.RaceId})
+	if status.Code(err) == codes.NotFound {
+		return s.settleDeleted(ctx, in.RaceId)
+	}
+
+	if err != nil {
+		return nil, racingError(err, "race %d not found", in.RaceId)
+	}
+
+	bets, err := s.settler.Settle(ctx, race)
+	if err != nil {
+		if errors.Is(err, settlement.ErrRaceNotFinal) {
+			return nil, status.Error(codes.FailedPrecondition, describe(err, settlement.ErrRaceNotFinal))
+		}
+
+		if _, ok := status.FromError(err); ok {
+			return nil, racingError(err, "race %d not found", in.RaceId)
+		}
+
+		return nil, toStatus(err)
+	}
+
+	return &betting.SettleRaceResponse{Bets: bets}, nil
+}
+
+func (s *bettingService) SettleEvent(ctx context.Context, in *betting.SettleEventRequest) (*betting.SettleEventResponse, error) {
+	if in.EventId <= 0 {
+		return nil, invalidField("event_id", "must be positive")
+	}
+
+	event, err := s.getEvent(ctx, in.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	bets, err := s.settler.SettleEvent(ctx, event)
+	if err != nil {
+		if errors.Is(err, settlement.ErrEventNotFinal) {
+			return nil, status.Error(codes.FailedPrecondition, describe(err, settlement.ErrEventNotFinal))
+		}
+
+		return nil, toStatus(err)
+	}
+
+	return &betting.SettleEventResponse{Bets: bets}, nil
+}
+
+// settleDeleted refunds the bets still open on a race the racing service no
+// longer has, as when it was deleted while bets were not being settled. A
+// race with no such bets is reported as not found.
+func (s *bettingService) settleDeleted(ctx context.Context, raceID int64) (*betting.SettleRaceResponse, error) {
+	bets, err := s.settler.SettleDeleted(ctx, raceID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	if len(bets) == 0 {
+		return nil, status.Errorf(codes.NotFound, "race %d not found", raceID)
+	}
+
+	return &betting.SettleRaceResponse{Bets: bets}, nil
+}
+
+// group keys the legs of a multi by the race or sports event they are on.
+type group struct{ raceID, eventID int64 }
+
+// price prices a selection of a runner of an OPEN race that is not
+// scratched at the runner's current fixed price, returning it as a leg.
+// Errors for fields of the selection are reported with the given prefix.
+func (s *bettingService) price(ctx context.Context, prefix string, selection *betting.PlaceBetRequest_Selection) (*betting.Leg, error) {
+	race, err := s.races.GetRace(ctx, &racing.GetRaceRequest{Id: selection.RaceId})
+	if err != nil {
+		return nil, racingError(err, "race %d not found", selection.RaceId)
+	}
+
+	if race.Status != racing.Race_OPEN {
+		return nil, status.Errorf(codes.FailedPrecondition, "race %d is %s, bets are only taken on OPEN races", race.Id, race.Status)
+	}
+
+	runners, err := s.races.ListRunners(ctx, &racing.ListRunnersRequest{RaceId: selection.RaceId})
+	if err != nil {
+		return nil, racingError(err, "race %d not found", selection.RaceId)
+	}
+
+	var (
+		runner   *racing.Runner
+		starters int
+	)
+
+	for _, r := range runners.Runners {
+		if r.Id == selection.RunnerId {
+			runner = r
+		}
+
+		if !r.Scratched {
+			starters++
+		}
+	}
+
+	if runner == nil {
+		return nil, invalidField(prefix+"runner_id", "is not a runner in the race")
+	}
+
+	if runner.Scratched {
+		return nil, status.Errorf(codes.FailedPrecondition, "runner %d is scratched", selection.RunnerId)
+	}
+
+	leg := &betting.Leg{
+		RaceId:   selection.RaceId,
+		RunnerId: selection.RunnerId,
+		Type:     selection.Type,
+		Places:   1,
+	}
+
+	// Place bets are paid at a fraction of the win price, over a number of
+	// places both set by the size of the field.
+	fraction := 1.0
+
+	if selection.Type == betting.Bet_PLACE {
+		var ok bool
+		if leg.Places, fraction, ok = placeTerms(starters); !ok {
+			return nil, status.Errorf(codes.FailedPrecondition, "race %d has %d starters, place bets need at least %d", selection.RaceId, starters, minPlaceStarters)
+		}
+	}
+
+	win, err := s.currentPrice(ctx, selection.RaceId, selection.RunnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	leg.Odds = math.Round((1+(win-1)*fraction)*100) / 100
+
+	if selection.Odds != 0 && math.Abs(selection.Odds-leg.Odds) >= 0.005 {
+		return nil, status.Errorf(codes.FailedPrecondition, "runner %d is now priced at %.2f for a %s bet, not %.2f", selection.RunnerId, leg.Odds, selection.Type, selection.Odds)
+	}
+
+	return leg, nil
+}
+
+// priceEvent prices a selection of a participant of an OPEN sports event at
+// the participant's current odds, returning it as a leg. Errors for fields
+// of the selection are reported with the given prefix.
+func (s *bettingService) priceEvent(ctx context.Context, prefix string, selection *betting.PlaceBetRequest_Selection) (*betting.Leg, error) {
+	event, err := s.getEvent(ctx, selection.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.Status != sports.Event_OPEN {
+		return nil, status.Errorf(codes.FailedPrecondition, "event %d is %s, bets are only taken on OPEN events", event.Id, event.Status)
+	}
+
+	position := -1
+
+	for i, participant := range event.Participants {
+		if participant == selection.Participant {
+			position = i
+		}
+	}
+
+	if position < 0 {
+		return nil, invalidField(prefix+"participant", "is not a participant in the event")
+	}
+
+	if position >= len(event.Odds) || event.Odds[position] <= 1 {
+		return nil, status.Errorf(codes.FailedPrecondition, "participant %q in event %d has no price", selection.Participant, event.Id)
+	}
+
+	leg := &betting.Leg{
+		EventId:     selection.EventId,
+		Participant: selection.Participant,
+		Type:        betting.Bet_WIN,
+		Odds:        event.Odds[position],
+		Places:      1,
+	}
+
+	if selection.Odds != 0 && math.Abs(selection.Odds-leg.Odds) >= 0.005 {
+		return nil, status.Errorf(codes.FailedPrecondition, "participant %q is now priced at %.2f, not %.2f", selection.Participant, leg.Odds, selection.Odds)
+	}
+
+	return leg, nil
+}
+
+// getEvent returns a sports event by its ID.
+func (s *bettingService) getEvent(ctx context.Context, id int64) (*sports.Event, error) {
+	resp, err := s.events.ListEvents(ctx, &sports.ListEventsRequest{Filter: &sports.ListEventsRequestFilter{Ids: []int64{id}}})
+	if err != nil {
+		return nil, sportsError(err)
+	}
+
+	if len(resp.Events) == 0 {
+		return nil, status.Errorf(codes.NotFound, "event %d not found", id)
+	}
+
+	return resp.Events[0], nil
+}
+
+// currentPrice returns the fixed win price currently offered on a runner.
+func (s *bettingService) currentPrice(ctx context.Context, raceID, runnerID int64) (float64, error) {
+	prices, err := s.prices.GetRacePrices(ctx, &racing.GetRacePricesRequest{RaceId: raceID})
+	if err != nil {
+		return 0, racingError(err, "race %d not found", raceID)
+	}
+
+	for _, runnerPrices := range prices.Prices {
+		if runnerPrices.RunnerId == runnerID && runnerPrices.Type == racing.Price_FIXED && runnerPrices.Current != nil {
+			return runnerPrices.Current.Odds, nil
+		}
+	}
+
+	return 0, status.Errorf(codes.FailedPrecondition, "runner %d has no fixed price", runnerID)
+}
+
+// minPlaceStarters is the smallest field place bets are taken on.
+const minPlaceStarters = 5
+
+// placeTerms returns the number of places paid, and the fraction of the win
+// odds paid on them, in a race with the given number of starters. Fields of
+// eight or more pay three places at a quarter of the odds, smaller fields two
+// places at a third, and fields of fewer than five are not bet on to place.
+func placeTerms(starters int) (places int64, fraction float64, ok bool) {
+	switch {
+	case starters >= 8:
+		return 3, 1.0 / 4, true
+	case starters >= minPlaceStarters:
+		return 2, 1.0 / 3, true
+	default:
+		return 0, 0, false
+	}
+}
+
+// racingError converts an error calling the racing service into one for our
+// own caller. A missing resource is reported with the given message; other
+// failures mean we cannot currently serve the request, whatever the racing
+// service said of ours.
+func racingError(err error, notFound string, args ...interface{}) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return status.Errorf(codes.NotFound, notFound, args...)
+	case codes.DeadlineExceeded, codes.Canceled:
+		return err
+	}
+
+	return status.Errorf(codes.Unavailable, "racing service: %s", status.Convert(err).Message())
+}
+
+// sportsError converts an error calling the sports service into one for our
+// own caller. As with the racing service, failures other than our caller's
+// deadline or cancellation mean we cannot currently serve the request.
+func sportsError(err error) error {
+	switch status.Code(err) {
+	case codes.DeadlineExceeded, codes.Canceled:
+		return err
+	}
+
+	return status.Errorf(codes.Unavailable, "sports service: %s", status.Convert(err).Message())
+}
+
+// accountsError converts an error calling the accounts service into one for
+// our own caller. A missing account is reported with the given message, and
+// funds that do not cover the stake as they were by the accounts service.
+func accountsError(err error, notFound string, args ...interface{}) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return status.Errorf(codes.NotFound, notFound, args...)
+	case codes.FailedPrecondition, codes.DeadlineExceeded, codes.Canceled:
+		return err
+	}
+
+	return status.Errorf(codes.Unavailable, "accounts service: %s", status.Convert(err).Message())
+}
+
+// toStatus converts context errors surfaced by the repository, such as a
+// cancelled request, into their gRPC status equivalents. Other errors are
+// returned unchanged.
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	}
+
+	return err
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"git.neds.sh/matty/entain/betting/db"
+	"git.neds.sh/matty/entain/betting/proto/accounts"
+	"git.neds.sh/matty/entain/betting/proto/betting"
+	"git.neds.sh/matty/entain/betting/proto/racing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeRacing is a racing client serving a fixed set of races and their
+// runners.
+type fakeRacing struct {
+	racing.RacingClient
+
+	races   map[int64]*racing.Race
+	runners map[int64][]*racing.Runner
+}
+
+func (f *fakeRacing) GetRace(ctx context.Context, in *racing.GetRaceRequest, opts ...grpc.CallOption) (*racing.Race, error) {
+	race, ok := f.races[in.Id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "race %d not found", in.Id)
+	}
+
+	return race, nil
+}
+
+func (f *fakeRacing) ListRunners(ctx context.Context, in *racing.ListRunnersRequest, opts ...grpc.CallOption) (*racing.ListRunnersResponse, error) {
+	return &racing.ListRunnersResponse{Runners: f.runners[in.RaceId]}, nil
+}
+
+// fakePrices is a prices client offering a fixed win price on each runner.
+type fakePrices struct {
+	racing.PricesClient
+
+	odds map[int64]float64
+}
+
+func (f *fakePrices) GetRacePrices(ctx context.Context, in *racing.GetRacePricesRequest, opts ...grpc.CallOption) (*racing.GetRacePricesResponse, error) {
+	resp := &racing.GetRacePricesResponse{}
+
+	for runnerID, odds := range f.odds {
+		resp.Prices = append(resp.Prices, &racing.RunnerPrices{
+			RunnerId: runnerID,
+			Type:     racing.Price_FIXED,
+			Current:  &racing.Price{RunnerId: runnerID, RaceId: in.RaceId, Type: racing.Price_FIXED, Odds: odds},
+		})
+	}
+
+	return resp, nil
+}
+
+// fakeWallet is an accounts client holding every stake reserved, and
+// recording those refunded.
+type fakeWallet struct {
+	accounts.AccountsClient
+
+	mu sync.Mutex
+	// reserved and refunded are the references of the stakes reserved and
+	// refunded, in order.
+	reserved, refunded []string
+}
+
+func (w *fakeWallet) ReserveStake(ctx context.Context, in *accounts.ReserveStakeRequest, opts ...grpc.CallOption) (*accounts.Transaction, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.reserved = append(w.reserved, in.Reference)
+
+	return &accounts.Transaction{AccountId: in.AccountId, Amount: -in.Amount, Reference: in.Reference}, nil
+}
+
+func (w *fakeWallet) RefundStake(ctx context.Context, in *accounts.RefundStakeRequest, opts ...grpc.CallOption) (*accounts.Transaction, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.refunded = append(w.refunded, in.Reference)
+
+	return &accounts.Transaction{Reference: in.Reference}, nil
+}
+
+// newTestBettingService returns a betting service over a new bets repository,
+// taking bets up to the given limits on race 1, which is OPEN, and race 2,
+// which is CLOSED. Runner 10 of race 1 is priced at 3, and runner 11 is
+// scratched.
+func newTestBettingService(t *testing.T, limits db.LiabilityLimits) (Betting, *fakeWallet) {
+	t.Helper()
+
+	bettingDB, err := db.Open(filepath.Join(t.TempDir(), "betting.db"))
+	if err != nil {
+		t.Fatalf("opening database: %s", err)
+	}
+	t.Cleanup(func() { _ = bettingDB.Close() })
+
+	repo := db.NewBetsRepo(bettingDB)
+	if err := repo.Init(context.Background()); err != nil {
+		t.Fatalf("initialising repository: %s", err)
+	}
+
+	races := &fakeRacing{
+		races: map[int64]*racing.Race{
+			1: {Id: 1, Status: racing.Race_OPEN},
+			2: {Id: 2, Status: racing.Race_CLOSED},
+		},
+		runners: map[int64][]*racing.Runner{
+			1: {{Id: 10, RaceId: 1}, {Id: 11, RaceId: 1, Scratched: true}},
+			2: {{Id: 20, RaceId: 2}},
+		},
+	}
+	prices := &fakePrices{odds: map[int64]float64{10: 3, 20: 2}}
+	wallet := &fakeWallet{}
+
+	return NewBettingService(repo, races, prices, nil, wallet, nil, limits), wallet
+}
+
+func TestPlaceBet(t *testing.T) {
+	noLimits := db.LiabilityLimits{Runner: 1 << 62, Race: 1 << 62, Event: 1 << 62}
+
+	for _, tc := range []struct {
+		name     string
+		in       *betting.PlaceBetRequest
+		wantCode codes.Code
+	}{
+		{"accepted", &betting.PlaceBetRequest{RaceId: 1, RunnerId: 10, Type: betting.Bet_WIN, Stake: 100, Odds: 3, AccountId: 7}, codes.OK},
+		{"at the current price", &betting.PlaceBetRequest{RaceId: 1, RunnerId: 10, Type: betting.Bet_WIN, Stake: 100, AccountId: 7}, codes.OK},
+		{"race not found", &betting.PlaceBetRequest{RaceId: 3, RunnerId: 30, Type: betting.Bet_WIN, Stake: 100, AccountId: 7}, codes.NotFound},
+		{"race not OPEN", &betting.PlaceBetRequest{RaceId: 2, RunnerId: 20, Type: betting.Bet_WIN, Stake: 100, AccountId: 7}, codes.FailedPrecondition},
+		{"unknown runner", &betting.PlaceBetRequest{RaceId: 1, RunnerId: 20, Type: betting.Bet_WIN, Stake: 100, AccountId: 7}, codes.InvalidArgument},
+		{"scratched runner", &betting.PlaceBetRequest{RaceId: 1, RunnerId: 11, Type: betting.Bet_WIN, Stake: 100, AccountId: 7}, codes.FailedPrecondition},
+		{"odds moved", &betting.PlaceBetRequest{RaceId: 1, RunnerId: 10, Type: betting.Bet_WIN, Stake: 100, Odds: 2.5, AccountId: 7}, codes.FailedPrecondition},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			svc, wallet := newTestBettingService(t, noLimits)
+
+			bet, err := svc.PlaceBet(context.Background(), tc.in)
+			if code := status.Code(err); code != tc.wantCode {
+				t.Fatalf("got code %s (%v), want %s", code, err, tc.wantCode)
+			}
+
+			if err != nil {
+				// Bets refused before they are priced never reserve a stake.
+				if len(wallet.reserved) != 0 {
+					t.Errorf("reserved stakes %v for a refused bet, want none", wallet.reserved)
+				}
+
+				return
+			}
+
+			if bet.Odds != 3 || bet.PotentialReturn != 300 || len(wallet.reserved) != 1 || bet.StakeReference != wallet.reserved[0] {
+				t.Errorf("placed bet at %v returning %d with stake %q, reserved %v; want odds 3 returning 300 with the stake reserved", bet.Odds, bet.PotentialReturn, bet.StakeReference, wallet.reserved)
+			}
+
+			if len(wallet.refunded) != 0 {
+				t.Errorf("refunded %v for an accepted bet, want none", wallet.refunded)
+			}
+		})
+	}
+}
+
+func TestPlaceBetLiabilityExceeded(t *testing.T) {
+	ctx := context.Background()
+
+	// A stake of 100 at 3 is a liability of 200, more than the runner limit.
+	svc, wallet := newTestBettingService(t, db.LiabilityLimits{Runner: 199, Race: 1 << 62, Event: 1 << 62})
+
+	_, err := svc.PlaceBet(ctx, &betting.PlaceBetRequest{RaceId: 1, RunnerId: 10, Type: betting.Bet_WIN, Stake: 100, AccountId: 7})
+	if code := status.Code(err); code != codes.FailedPrecondition {
+		t.Fatalf("got code %s (%v), want FailedPrecondition", code, err)
+	}
+
+	// The stake reserved for the bet is refunded.
+	if len(wallet.reserved) != 1 || len(wallet.refunded) != 1 || wallet.refunded[0] != wallet.reserved[0] {
+		t.Errorf("reserved %v and refunded %v, want the stake reserved refunded", wallet.reserved, wallet.refunded)
+	}
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"git.neds.sh/matty/entain/betting/proto/betting"
+	"git.neds.sh/matty/entain/betting/proto/racing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// priceSameRace prices the legs of a multi on the same race together. Their
+// odds cannot simply be multiplied, as the legs are not independent: only
+// one runner can win, and each runner finishing in the places leaves one
+// place fewer for the others. So the chance of every leg winning together
+// is worked out from the runners' fixed win prices, and the legs' odds
+// multiplied together are adjusted by how much likelier, or less likely,
+// that is than the legs winning independently. The house's margin on each
+// leg's odds is so kept.
+func (s *bettingService) priceSameRace(ctx context.Context, raceID int64, legs []*betting.Leg) (float64, error) {
+	runners, err := s.races.ListRunners(ctx, &racing.ListRunnersRequest{RaceId: raceID})
+	if err != nil {
+		return 0, racingError(err, "race %d not found", raceID)
+	}
+
+	prices, err := s.prices.GetRacePrices(ctx, &racing.GetRacePricesRequest{RaceId: raceID})
+	if err != nil {
+		return 0, racingError(err, "race %d not found", raceID)
+	}
+
+	starters := make(map[int64]bool)
+
+	for _, runner := range runners.Runners {
+		if !runner.Scratched {
+			starters[runner.Id] = true
+		}
+	}
+
+	odds := make(map[int64]float64)
+
+	for _, runnerPrices := range prices.Prices {
+		if starters[runnerPrices.RunnerId] && runnerPrices.Type == racing.Price_FIXED && runnerPrices.Current != nil {
+			odds[runnerPrices.RunnerId] = runnerPrices.Current.Odds
+		}
+	}
+
+	price, ok := sameRaceOdds(legs, winChances(odds))
+	if !ok {
+		return 0, status.Errorf(codes.FailedPrecondition, "the legs on race %d cannot all win together", raceID)
+	}
+
+	return price, nil
+}
+
+// winChances returns each runner's chance of winning implied by its win
+// odds, scaled so that the chances sum to 1 and so exclude the margin.
+func winChances(odds map[int64]float64) map[int64]float64 {
+	var total float64
+
+	for _, o := range odds {
+		total += 1 / o
+	}
+
+	chances := make(map[int64]float64, len(odds))
+
+	for runnerID, o := range odds {
+		chances[runnerID] = 1 / o / total
+	}
+
+	return chances
+}
+
+// sameRaceOdds returns the odds of legs on the same race priced together,
+// given each starter's chance of winning, rounded to the cent. It reports
+// false should the legs be unable to all win together, as when there are
+// more than there are places paid.
+func sameRaceOdds(legs []*betting.Leg, chances map[int64]float64) (float64, bool) {
+	together := finishChance(legs, chances)
+	if together <= 0 {
+		return 0, false
+	}
+
+	odds := 1.0
+
+	for _, leg := range legs {
+		odds *= leg.Odds * finishChance([]*betting.Leg{leg}, chances)
+	}
+
+	return math.Round(odds/together*100) / 100, true
+}
+
+// finishChance returns the chance of every leg's runner finishing within its
+// leg's places, given each starter's chance of winning. Under the Harville
+// model used, a runner's chance of finishing in each place is its chance of
+// winning among the runners not already placed ahead of it.
+func finishChance(legs []*betting.Leg, chances map[int64]float64) float64 {
+	var depth int64
+
+	for _, leg := range legs {
+		if leg.Places > depth {
+			depth = leg.Places
+		}
+	}
+
+	runners := make([]int64, 0, len(chances))
+	for runnerID := range chances {
+		runners = append(runners, runnerID)
+	}
+
+	// Walked in a fixed order, so the chance summed does not vary with the
+	// order of the map.
+	sort.Slice(runners, func(i, j int) bool { return runners[i] < runners[j] })
+
+	placed := make(map[int64]bool)
+
+	// walk sums the chances of the finishing orders from the given position
+	// on, given those ahead of it, in which every leg's runner finishes
+	// within its places. Once every leg's runner has, the order of the rest
+	// does not matter.
+	var walk func(position int64, chance, remaining float64) float64
+
+	walk = func(position int64, chance, remaining float64) float64 {
+		done := true
+
+		for _, leg := range legs {
+			if placed[leg.RunnerId] {
+				continue
+			}
+
+			if leg.Places < position {
+				return 0
+			}
+
+			done = false
+		}
+
+		if done {
+			return chance
+		}
+
+		if position > depth || remaining <= 0 {
+			return 0
+		}
+
+		var total float64
+
+		for _, runnerID := range runners {
+			if placed[runnerID] {
+				continue
+			}
+
+			placed[runnerID] = true
+			total += walk(position+1, chance*chances[runnerID]/remaining, remaining-chances[runnerID])
+			placed[runnerID] = false
+		}
+
+		return total
+	}
+
+	return walk(1, 1, 1)
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"math"
+	"testing"
+
+	"git.neds.sh/matty/entain/betting/proto/betting"
+)
+
+func TestWinChances(t *testing.T) {
+	// Implied chances of 5/9, 5/18 and 5/18 carry a margin of a ninth.
+	got := winChances(map[int64]float64{1: 1.8, 2: 3.6, 3: 3.6})
+
+	for runnerID, want := range map[int64]float64{1: 0.5, 2: 0.25, 3: 0.25} {
+		if math.Abs(got[runnerID]-want) > 1e-9 {
+			t.Errorf("runner %d has a chance of %v, want %v", runnerID, got[runnerID], want)
+		}
+	}
+}
+
+func TestSameRaceOdds(t *testing.T) {
+	// Four runners with an even chance of winning, and two with a 60 and 40
+	// per cent chance.
+	even := map[int64]float64{1: 0.25, 2: 0.25, 3: 0.25, 4: 0.25}
+	pair := map[int64]float64{1: 0.6, 2: 0.4}
+
+	win := func(runnerID int64, odds float64) *betting.Leg {
+		return &betting.Leg{RunnerId: runnerID, Type: betting.Bet_WIN, Odds: odds, Places: 1}
+	}
+	place := func(runnerID int64, odds float64, places int64) *betting.Leg {
+		return &betting.Leg{RunnerId: runnerID, Type: betting.Bet_PLACE, Odds: odds, Places: places}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		legs     []*betting.Leg
+		chances  map[int64]float64
+		wantOdds float64
+		wantOK   bool
+	}{
+		// Runner 1 wins a quarter of the time, and runner 2 then runs second
+		// a third of the time, so a twelfth together: 12 at fair odds, where
+		// fair odds of 4 and 2 multiply to 8. Odds of 4 and 1.5 carry a
+		// margin on the place leg, which is kept.
+		{"win and place", []*betting.Leg{win(1, 4), place(2, 1.5, 2)}, even, 9, true},
+		// Whenever one of two runners wins, the other runs second.
+		{"win and place of two", []*betting.Leg{win(1, 1.67), place(2, 1, 2)}, pair, 1.67, true},
+		// Two of four runners fill the two places a sixth of the time, where
+		// each places half of it.
+		{"two places", []*betting.Leg{place(1, 2, 2), place(2, 2, 2)}, even, 6, true},
+		{"more legs than places", []*betting.Leg{place(1, 2, 2), place(2, 2, 2), place(3, 2, 2)}, even, 0, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := sameRaceOdds(tc.legs, tc.chances)
+			if ok != tc.wantOK || math.Abs(got-tc.wantOdds) > 1e-9 {
+				t.Errorf("priced at %v (ok = %t), want %v (ok = %t)", got, ok, tc.wantOdds, tc.wantOK)
+			}
+		})
+	}
+}
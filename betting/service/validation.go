@@ -0,0 +1,244 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"git.neds.sh/matty/entain/betting/proto/betting"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxStake caps the stake of a single bet, in cents.
+	maxStake = 10000000
+
+	// maxOdds caps the decimal odds a bet may be requested at, matching the
+	// longest price the racing service offers.
+	maxOdds = 1001
+
+	// maxLegs caps the number of legs of a multi.
+	maxLegs = 10
+
+	// maxMultiOdds caps the odds of a multi, their legs' multiplied together.
+	maxMultiOdds = 100000
+)
+
+// violations collects the fields of a request that failed validation.
+type violations []*errdetails.BadRequest_FieldViolation
+
+// add records a field that failed validation.
+func (v *violations) add(field, description string) {
+	*v = append(*v, &errdetails.BadRequest_FieldViolation{Field: field, Description: description})
+}
+
+// err returns an InvalidArgument error carrying a BadRequest detail listing
+// every violation, or nil when there are none.
+func (v violations) err() error {
+	if len(v) == 0 {
+		return nil
+	}
+
+	message := fmt.Sprintf("invalid %s: %s", v[0].Field, v[0].Description)
+	if len(v) > 1 {
+		message += fmt.Sprintf(" (and %d more)", len(v)-1)
+	}
+
+	st, err := status.New(codes.InvalidArgument, message).WithDetails(&errdetails.BadRequest{FieldViolations: v})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, message)
+	}
+
+	return st.Err()
+}
+
+// invalidField returns an InvalidArgument error for a single field.
+func invalidField(field, description string) error {
+	var v violations
+	v.add(field, description)
+
+	return v.err()
+}
+
+// describe returns the description of an error wrapping a sentinel, without
+// the sentinel's own message repeating the field name.
+func describe(err, sentinel error) string {
+	return strings.TrimPrefix(err.Error(), sentinel.Error()+": ")
+}
+
+// validatePlaceBetRequest checks a PlaceBetRequest is well formed.
+func validatePlaceBetRequest(in *betting.PlaceBetRequest) error {
+	var v violations
+
+	if in.Type == betting.Bet_MULTI {
+		if in.RaceId != 0 {
+			v.add("race_id", "must be unset for a MULTI, whose legs select races")
+		}
+
+		if in.RunnerId != 0 {
+			v.add("runner_id", "must be unset for a MULTI, whose legs select runners")
+		}
+
+		if len(in.Legs) < 2 || len(in.Legs) > maxLegs {
+			v.add("legs", fmt.Sprintf("a MULTI must have between 2 and %d legs", maxLegs))
+		}
+
+		type runner struct{ raceID, runnerID int64 }
+
+		var (
+			runners = make(map[runner]int)
+			winners = make(map[int64]int)
+			events  = make(map[int64]int)
+		)
+
+		for i, leg := range in.Legs {
+			prefix := fmt.Sprintf("legs[%d].", i)
+
+			if leg.EventId != 0 || leg.Participant != "" {
+				validateEventSelection(prefix, leg, &v)
+
+				// Only one participant can win an event.
+				if j, ok := events[leg.EventId]; ok {
+					v.add(prefix+"event_id", fmt.Sprintf("is already selected by legs[%d]", j))
+				} else {
+					events[leg.EventId] = i
+				}
+
+				continue
+			}
+
+			validateSelection(prefix, leg.RaceId, leg.RunnerId, leg.Type, leg.Odds, &v)
+
+			if leg.Type == betting.Bet_MULTI {
+				v.add(prefix+"type", "must be WIN or PLACE")
+			}
+
+			// Legs may share a race, as a same-race multi, but not a runner,
+			// and only one runner can win a race.
+			selected := runner{leg.RaceId, leg.RunnerId}
+
+			if j, ok := runners[selected]; ok {
+				v.add(prefix+"runner_id", fmt.Sprintf("is already selected by legs[%d]", j))
+			} else {
+				runners[selected] = i
+			}
+
+			if leg.Type == betting.Bet_WIN {
+				if j, ok := winners[leg.RaceId]; ok {
+					v.add(prefix+"type", fmt.Sprintf("must be PLACE, as legs[%d] is a WIN leg on the same race", j))
+				} else {
+					winners[leg.RaceId] = i
+				}
+			}
+		}
+	} else {
+		validateSelection("", in.RaceId, in.RunnerId, in.Type, in.Odds, &v)
+
+		if len(in.Legs) > 0 {
+			v.add("legs", "must be unset unless the type is MULTI")
+		}
+	}
+
+	if in.AccountId <= 0 {
+		v.add("account_id", "must be positive")
+	}
+
+	if in.Stake <= 0 || in.Stake > maxStake {
+		v.add("stake", fmt.Sprintf("must be positive and at most %d cents", maxStake))
+	}
+
+	// Written so that NaN fails too.
+	if in.Type == betting.Bet_MULTI && in.Odds != 0 && !(in.Odds > 1 && in.Odds <= maxMultiOdds) {
+		v.add("odds", fmt.Sprintf("must be unset, or greater than 1 and at most %d", maxMultiOdds))
+	}
+
+	return v.err()
+}
+
+// validateSelection records the violations of a selection of a runner, with
+// field names prefixed by the selection's. Odds are only checked if set.
+func validateSelection(prefix string, raceID, runnerID int64, betType betting.Bet_Type, odds float64, v *violations) {
+	if raceID <= 0 {
+		v.add(prefix+"race_id", "must be positive")
+	}
+
+	if runnerID <= 0 {
+		v.add(prefix+"runner_id", "must be positive")
+	}
+
+	if betType == betting.Bet_TYPE_UNSPECIFIED {
+		v.add(prefix+"type", "must be specified")
+	} else if _, ok := betting.Bet_Type_name[int32(betType)]; !ok {
+		v.add(prefix+"type", fmt.Sprintf("unknown bet type %d", betType))
+	}
+
+	// Written so that NaN fails too.
+	if odds != 0 && !(odds > 1 && odds <= maxOdds) {
+		v.add(prefix+"odds", fmt.Sprintf("must be unset, or greater than 1 and at most %d", maxOdds))
+	}
+}
+
+// validateEventSelection records the violations of a selection of a
+// participant of a sports event, with field names prefixed by the
+// selection's.
+func validateEventSelection(prefix string, leg *betting.PlaceBetRequest_Selection, v *violations) {
+	if leg.EventId <= 0 {
+		v.add(prefix+"event_id", "must be positive")
+	}
+
+	if leg.Participant == "" {
+		v.add(prefix+"participant", "must be set when selecting an event")
+	}
+
+	if leg.RaceId != 0 {
+		v.add(prefix+"race_id", "must be unset when selecting an event")
+	}
+
+	if leg.RunnerId != 0 {
+		v.add(prefix+"runner_id", "must be unset when selecting an event")
+	}
+
+	if leg.Type != betting.Bet_WIN {
+		v.add(prefix+"type", "must be WIN, the only type taken on events")
+	}
+
+	// Written so that NaN fails too.
+	if leg.Odds != 0 && !(leg.Odds > 1 && leg.Odds <= maxOdds) {
+		v.add(prefix+"odds", fmt.Sprintf("must be unset, or greater than 1 and at most %d", maxOdds))
+	}
+}
+
+// validateListBetsRequest checks a ListBetsRequest is well formed. The page
+// token is checked when it is decoded.
+func validateListBetsRequest(in *betting.ListBetsRequest) error {
+	var v violations
+
+	if in.PageSize < 0 {
+		v.add("page_size", "must not be negative")
+	}
+
+	if filter := in.Filter; filter != nil {
+		if filter.RaceId < 0 {
+			v.add("filter.race_id", "must not be negative")
+		}
+
+		if filter.RunnerId < 0 {
+			v.add("filter.runner_id", "must not be negative")
+		}
+
+		if filter.AccountId < 0 {
+			v.add("filter.account_id", "must not be negative")
+		}
+
+		if filter.EventId < 0 {
+			v.add("filter.event_id", "must not be negative")
+		}
+
+		if _, ok := betting.Bet_Status_name[int32(filter.Status)]; !ok {
+			v.add("filter.status", fmt.Sprintf("unknown bet status %d", filter.Status))
+		}
+	}
+
+	return v.err()
+}
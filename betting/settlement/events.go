@@ -0,0 +1,141 @@
+package settlement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"git.neds.sh/matty/entain/betting/db"
+	"git.neds.sh/matty/entain/betting/proto/betting"
+	"git.neds.sh/matty/entain/betting/proto/sports"
+)
+
+// ErrEventNotFinal is returned when settling a sports event that is neither
+// RESULTED nor ABANDONED.
+var ErrEventNotFinal = errors.New("event is not final")
+
+// EventFinal reports whether legs on a sports event of the given status can
+// be settled.
+func EventFinal(eventStatus sports.Event_Status) bool {
+	return eventStatus == sports.Event_RESULTED || eventStatus == sports.Event_ABANDONED
+}
+
+// SettleEvent settles the legs of multis still pending on a sports event,
+// and the multis they decide, returning the bets changed. The event must be
+// RESULTED or ABANDONED. As with races, legs already settled are left as
+// they are, so an event can be settled again without paying any bet twice.
+//
+// The bets settled are then paid. Should paying fail, the bets remain
+// settled, and are paid by a later call to Pay.
+func (s *Settler) SettleEvent(ctx context.Context, event *sports.Event) ([]*betting.Bet, error) {
+	if !EventFinal(event.Status) {
+		return nil, fmt.Errorf("%w: event %d is %s, only RESULTED or ABANDONED events are settled", ErrEventNotFinal, event.Id, event.Status)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	bets, err := s.betsRepo.ListOpenOnEvent(ctx, event.Id)
+	if err != nil || len(bets) == 0 {
+		return nil, err
+	}
+
+	settlements := make([]db.Settlement, 0, len(bets))
+
+	for _, bet := range bets {
+		outcomes := make(map[int]outcome)
+
+		for i, leg := range bet.Legs {
+			if leg.EventId == event.Id && leg.Status == betting.Leg_PENDING {
+				outcomes[i] = resolveEvent(leg.Participant, leg.Odds, event)
+			}
+		}
+
+		settlement := settleMulti(bet, outcomes)
+		settlement.EventID = event.Id
+
+		settlements = append(settlements, settlement)
+	}
+
+	settled, err := s.betsRepo.Settle(ctx, settlements)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Pay(ctx); err != nil {
+		log.Printf("settlement: paying bets settled on event %d failed: %s\n", event.Id, err)
+	}
+
+	return settled, nil
+}
+
+// resolveEvent decides a selection of a participant to win a sports event
+// at the given odds. Selections on an abandoned event are void. Otherwise a
+// selection wins, paying its odds, when its participant won, and is lost to
+// another participant or a draw.
+func resolveEvent(participant string, odds float64, event *sports.Event) outcome {
+	switch {
+	case event.Status == sports.Event_ABANDONED:
+		return outcome{status: betting.Leg_VOID, dividend: 1, reason: "event abandoned"}
+	case event.Winner == participant:
+		return outcome{status: betting.Leg_WON, dividend: odds, reason: fmt.Sprintf("%s won", participant)}
+	case event.Winner == "":
+		return outcome{status: betting.Leg_LOST, reason: "event drawn"}
+	default:
+		return outcome{status: betting.Leg_LOST, reason: fmt.Sprintf("%s won", event.Winner)}
+	}
+}
+
+// SettleEvents settles the legs on every sports event with legs pending
+// that the sports service has since RESULTED or ABANDONED. The sports
+// service has no stream of events to watch, so is asked after those events
+// instead.
+func (s *Settler) SettleEvents(ctx context.Context) error {
+	pending, err := s.betsRepo.ListPendingEvents(ctx)
+	if err != nil || len(pending) == 0 {
+		return err
+	}
+
+	resp, err := s.events.ListEvents(ctx, &sports.ListEventsRequest{Filter: &sports.ListEventsRequestFilter{Ids: pending}})
+	if err != nil {
+		return err
+	}
+
+	for _, event := range resp.Events {
+		if !EventFinal(event.Status) {
+			continue
+		}
+
+		bets, err := s.SettleEvent(ctx, event)
+		if err != nil {
+			return err
+		}
+
+		if len(bets) > 0 {
+			log.Printf("settlement: settled %d bets on %s event %d\n", len(bets), event.Status, event.Id)
+		}
+	}
+
+	return nil
+}
+
+// PollEvents settles the legs on sports events at the given interval until
+// the context is done.
+func (s *Settler) PollEvents(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.SettleEvents(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("settlement: settling sports events failed, retrying in %s: %s\n", interval, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package settlement
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"git.neds.sh/matty/entain/betting/db"
+	"git.neds.sh/matty/entain/betting/proto/betting"
+	"git.neds.sh/matty/entain/betting/proto/sports"
+	"google.golang.org/grpc"
+)
+
+// fakeEvents is a sports client listing a fixed set of events.
+type fakeEvents struct {
+	sports.SportsClient
+
+	events map[int64]*sports.Event
+}
+
+func (f *fakeEvents) ListEvents(ctx context.Context, in *sports.ListEventsRequest, opts ...grpc.CallOption) (*sports.ListEventsResponse, error) {
+	resp := &sports.ListEventsResponse{}
+
+	for _, id := range in.GetFilter().GetIds() {
+		if event, ok := f.events[id]; ok {
+			resp.Events = append(resp.Events, event)
+		}
+	}
+
+	return resp, nil
+}
+
+func TestResolveEvent(t *testing.T) {
+	won := &sports.Event{Id: 1, Status: sports.Event_RESULTED, Winner: "Lakers"}
+	drawn := &sports.Event{Id: 1, Status: sports.Event_RESULTED}
+	abandoned := &sports.Event{Id: 1, Status: sports.Event_ABANDONED}
+
+	for _, tc := range []struct {
+		name         string
+		participant  string
+		event        *sports.Event
+		wantStatus   betting.Leg_Status
+		wantDividend float64
+	}{
+		{"won", "Lakers", won, betting.Leg_WON, 1.8},
+		{"lost", "Celtics", won, betting.Leg_LOST, 0},
+		{"drawn", "Lakers", drawn, betting.Leg_LOST, 0},
+		{"abandoned", "Lakers", abandoned, betting.Leg_VOID, 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := resolveEvent(tc.participant, 1.8, tc.event)
+			if got.status != tc.wantStatus || got.dividend != tc.wantDividend {
+				t.Errorf("resolved as %s at %v, want %s at %v", got.status, got.dividend, tc.wantStatus, tc.wantDividend)
+			}
+
+			if got.reason == "" {
+				t.Error("resolved without a reason")
+			}
+		})
+	}
+}
+
+func TestSettleEvents(t *testing.T) {
+	ctx := context.Background()
+
+	bettingDB, err := db.Open(filepath.Join(t.TempDir(), "betting.db"))
+	if err != nil {
+		t.Fatalf("opening database: %s", err)
+	}
+	t.Cleanup(func() { _ = bettingDB.Close() })
+
+	repo := db.NewBetsRepo(bettingDB)
+	if err := repo.Init(ctx); err != nil {
+		t.Fatalf("initialising repository: %s", err)
+	}
+
+	// A multi of Lakers at 1.5 in event 5 and Ash Barty at 2 in event 6,
+	// priced at 3.
+	multi, err := repo.Create(ctx, &betting.Bet{
+		Type:            betting.Bet_MULTI,
+		Stake:           100,
+		Odds:            3,
+		PotentialReturn: 300,
+		AccountId:       7,
+		StakeReference:  "bet-a",
+		Legs: []*betting.Leg{
+			{EventId: 5, Participant: "Lakers", Type: betting.Bet_WIN, Odds: 1.5, Places: 1, SameRaceOdds: 1.5},
+			{EventId: 6, Participant: "Ash Barty", Type: betting.Bet_WIN, Odds: 2, Places: 1, SameRaceOdds: 2},
+		},
+	}, db.LiabilityLimits{Runner: 1 << 62, Race: 1 << 62, Event: 1 << 62})
+	if err != nil {
+		t.Fatalf("creating multi: %s", err)
+	}
+
+	events := &fakeEvents{events: map[int64]*sports.Event{
+		5: {Id: 5, Status: sports.Event_RESULTED, Winner: "Lakers"},
+		6: {Id: 6, Status: sports.Event_CLOSED},
+	}}
+	wallet := &fakeWallet{}
+	settler := NewSettler(repo, nil, events, wallet)
+
+	// Only event 5 is resulted, so the multi awaits event 6.
+	if err := settler.SettleEvents(ctx); err != nil {
+		t.Fatalf("settling events: %s", err)
+	}
+
+	got, err := repo.Get(ctx, multi.Id)
+	if err != nil {
+		t.Fatalf("getting multi: %s", err)
+	}
+
+	if got.Status != betting.Bet_ACCEPTED || got.Legs[0].Status != betting.Leg_WON || got.Legs[1].Status != betting.Leg_PENDING {
+		t.Fatalf("after event 5: multi %s with legs %v, want ACCEPTED with the first leg WON", got.Status, got.Legs)
+	}
+
+	events.events[6] = &sports.Event{Id: 6, Status: sports.Event_RESULTED, Winner: "Ash Barty"}
+
+	if err := settler.SettleEvents(ctx); err != nil {
+		t.Fatalf("settling events again: %s", err)
+	}
+
+	if got, err = repo.Get(ctx, multi.Id); err != nil {
+		t.Fatalf("getting multi: %s", err)
+	}
+
+	if got.Status != betting.Bet_WON || got.Payout != 300 {
+		t.Errorf("after event 6: multi %s paying %d, want WON paying 300", got.Status, got.Payout)
+	}
+
+	if paid := wallet.references(); len(paid) != 1 || paid[0] != "bet-a" {
+		t.Errorf("paid %v, want bet-a", paid)
+	}
+
+	// Settling a CLOSED event is refused.
+	if _, err := settler.SettleEvent(ctx, &sports.Event{Id: 8, Status: sports.Event_CLOSED}); !errors.Is(err, ErrEventNotFinal) {
+		t.Errorf("settling a CLOSED event: err = %v, want ErrEventNotFinal", err)
+	}
+}
@@ -0,0 +1,93 @@
+package settlement
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"git.neds.sh/matty/entain/betting/proto/accounts"
+	"git.neds.sh/matty/entain/betting/proto/betting"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Pay pays the settlements of bets still unpaid to the accounts the bets
+// were placed from: the stakes of bets won and lost are paid out, with the
+// payout if any, and those of bets refunded returned. The accounts service
+// pays each stake's settlement once, however often it is asked, so a payment
+// that fails transiently, as while the accounts service is unavailable, is
+// retried by a later call. Such a failure ends the call, as the payments after
+// it would most likely fail alike.
+//
+// A payment the accounts service refuses outright, as for a stake it has no
+// record of, would fail however often it was retried, so is logged and
+// recorded against its settlement, and the payments after it are made.
+func (s *Settler) Pay(ctx context.Context) error {
+	s.payMu.Lock()
+	defer s.payMu.Unlock()
+
+	payments, err := s.betsRepo.ListUnpaid(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, payment := range payments {
+		if payment.Status == betting.Bet_REFUNDED {
+			_, err = s.wallet.RefundStake(ctx, &accounts.RefundStakeRequest{Reference: payment.StakeReference})
+		} else {
+			_, err = s.wallet.PayoutStake(ctx, &accounts.PayoutStakeRequest{Reference: payment.StakeReference, Amount: payment.Payout})
+		}
+
+		if err != nil {
+			if ctx.Err() != nil || transient(err) {
+				return fmt.Errorf("paying bet %d to account %d: %w", payment.BetID, payment.AccountID, err)
+			}
+
+			log.Printf("settlement: paying bet %d to account %d failed, not retrying: %s\n", payment.BetID, payment.AccountID, err)
+
+			if err := s.betsRepo.MarkPaymentFailed(ctx, payment.BetID, err.Error()); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := s.betsRepo.MarkPaid(ctx, payment.BetID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// transient reports whether a call to the accounts service failed for a
+// reason that may pass, so is worth retrying.
+func transient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Aborted, codes.ResourceExhausted:
+		return true
+	}
+
+	return false
+}
+
+// RetryPayments pays unpaid settlements at the given interval until the
+// context is done, so that payments failed, as while the accounts service
+// was unavailable, are made once it is back.
+func (s *Settler) RetryPayments(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Pay(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("settlement: paying settlements failed, retrying in %s: %s\n", interval, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
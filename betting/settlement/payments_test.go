@@ -0,0 +1,164 @@
+package settlement
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+
+	"git.neds.sh/matty/entain/betting/db"
+	"git.neds.sh/matty/entain/betting/proto/accounts"
+	"git.neds.sh/matty/entain/betting/proto/betting"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeWallet is an accounts client paying stakes, other than those it is
+// told to fail.
+type fakeWallet struct {
+	accounts.AccountsClient
+
+	mu sync.Mutex
+	// failures are the errors returned for stake references.
+	failures map[string]error
+	// paid are the references of the stakes paid out or refunded, in order.
+	paid []string
+}
+
+func (w *fakeWallet) PayoutStake(ctx context.Context, in *accounts.PayoutStakeRequest, opts ...grpc.CallOption) (*accounts.Transaction, error) {
+	return w.pay(in.Reference)
+}
+
+func (w *fakeWallet) RefundStake(ctx context.Context, in *accounts.RefundStakeRequest, opts ...grpc.CallOption) (*accounts.Transaction, error) {
+	return w.pay(in.Reference)
+}
+
+func (w *fakeWallet) pay(reference string) (*accounts.Transaction, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err := w.failures[reference]; err != nil {
+		return nil, err
+	}
+
+	w.paid = append(w.paid, reference)
+
+	return &accounts.Transaction{Reference: reference}, nil
+}
+
+func (w *fakeWallet) references() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return append([]string(nil), w.paid...)
+}
+
+// settledBets opens a bets repository holding bets staked with the given
+// references, settled in the same order.
+func settledBets(t *testing.T, references ...string) db.BetsRepo {
+	t.Helper()
+
+	ctx := context.Background()
+
+	bettingDB, err := db.Open(filepath.Join(t.TempDir(), "betting.db"))
+	if err != nil {
+		t.Fatalf("opening database: %s", err)
+	}
+	t.Cleanup(func() { _ = bettingDB.Close() })
+
+	repo := db.NewBetsRepo(bettingDB)
+	if err := repo.Init(ctx); err != nil {
+		t.Fatalf("initialising repository: %s", err)
+	}
+
+	var settlements []db.Settlement
+
+	for i, reference := range references {
+		bet, err := repo.Create(ctx, &betting.Bet{
+			RaceId:          1,
+			RunnerId:        int64(i + 1),
+			Type:            betting.Bet_WIN,
+			Stake:           100,
+			Odds:            2,
+			Places:          1,
+			PotentialReturn: 200,
+			AccountId:       7,
+			StakeReference:  reference,
+		}, db.LiabilityLimits{Runner: 1 << 62, Race: 1 << 62, Event: 1 << 62})
+		if err != nil {
+			t.Fatalf("creating bet: %s", err)
+		}
+
+		settlements = append(settlements, db.Settlement{BetID: bet.Id, RaceID: 1, Status: betting.Bet_WON, Payout: 200, Reason: "finished 1st"})
+	}
+
+	if _, err := repo.Settle(ctx, settlements); err != nil {
+		t.Fatalf("settling bets: %s", err)
+	}
+
+	return repo
+}
+
+func TestPayContinuesPastRefusedPayments(t *testing.T) {
+	ctx := context.Background()
+	repo := settledBets(t, "bet-a", "bet-b", "bet-c", "bet-d")
+	wallet := &fakeWallet{failures: map[string]error{
+		"bet-b": status.Error(codes.NotFound, "no stake held for bet-b"),
+		"bet-c": status.Error(codes.FailedPrecondition, "stake for bet-c already refunded"),
+	}}
+	settler := NewSettler(repo, nil, nil, wallet)
+
+	if err := settler.Pay(ctx); err != nil {
+		t.Fatalf("paying: %s", err)
+	}
+
+	if got, want := wallet.references(), []string{"bet-a", "bet-d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("paid %v, want %v", got, want)
+	}
+
+	// The refused payments are recorded, so are neither listed as unpaid nor
+	// tried again.
+	if unpaid, err := repo.ListUnpaid(ctx); err != nil || len(unpaid) != 0 {
+		t.Errorf("unpaid after paying: %v, %v, want none", unpaid, err)
+	}
+
+	wallet.failures = nil
+
+	if err := settler.Pay(ctx); err != nil {
+		t.Fatalf("paying again: %s", err)
+	}
+
+	if got, want := wallet.references(), []string{"bet-a", "bet-d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("paid %v after paying again, want %v", got, want)
+	}
+}
+
+func TestPayRetriesTransientFailures(t *testing.T) {
+	ctx := context.Background()
+	repo := settledBets(t, "bet-a", "bet-b", "bet-c")
+	wallet := &fakeWallet{failures: map[string]error{
+		"bet-b": status.Error(codes.Unavailable, "connection refused"),
+	}}
+	settler := NewSettler(repo, nil, nil, wallet)
+
+	if err := settler.Pay(ctx); err == nil {
+		t.Fatal("paying while the accounts service is unavailable succeeded")
+	}
+
+	if got, want := wallet.references(), []string{"bet-a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("paid %v, want %v", got, want)
+	}
+
+	// Once the accounts service is back, the rest are paid.
+	wallet.failures = nil
+
+	if err := settler.Pay(ctx); err != nil {
+		t.Fatalf("paying again: %s", err)
+	}
+
+	if got, want := wallet.references(), []string{"bet-a", "bet-b", "bet-c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("paid %v after paying again, want %v", got, want)
+	}
+}
@@ -0,0 +1,264 @@
+package settlement
+
+import (
+	"fmt"
+	"math"
+
+	"git.neds.sh/matty/entain/betting/db"
+	"git.neds.sh/matty/entain/betting/proto/betting"
+	"git.neds.sh/matty/entain/betting/proto/racing"
+)
+
+// outcome is the outcome of a selection of a runner: WON with the dividend
+// paid on each unit staked, LOST, or VOID.
+type outcome struct {
+	status   betting.Leg_Status
+	dividend float64
+	reason   string
+}
+
+// resolve decides a selection of a runner at the given odds on a resulted
+// race, paying the given number of places. Selections of scratched runners
+// are void. Otherwise a selection wins when its runner finished within the
+// places paid, and pays its odds.
+//
+// Runners dead heating share the positions they span, so a dead heat of two
+// for first spans first and second. When only some of the positions spanned
+// are paid, the stake is divided between the runners, and only the share for
+// the positions paid is paid out; the rest is lost. A WIN selection of one of
+// two runners dead heating for first pays half its odds, as does a PLACE
+// selection paying three places of one of two dead heating for third.
+func resolve(runnerID, places int64, odds float64, result *racing.RaceResult, scratched map[int64]bool) outcome {
+	if scratched[runnerID] {
+		return outcome{status: betting.Leg_VOID, dividend: 1, reason: "runner scratched"}
+	}
+
+	var position, sharing int64
+
+	for _, placing := range result.Placings {
+		if placing.RunnerId == runnerID {
+			position = placing.Position
+		}
+	}
+
+	for _, placing := range result.Placings {
+		if position != 0 && placing.Position == position {
+			sharing++
+		}
+	}
+
+	if places < 1 {
+		places = 1
+	}
+
+	// The positions spanned by the runner, and those of them paid.
+	last := position + sharing - 1
+	paid := min(last, places) - position + 1
+
+	if position == 0 {
+		return outcome{status: betting.Leg_LOST, reason: "finished outside the places paid"}
+	}
+
+	if paid <= 0 {
+		return outcome{status: betting.Leg_LOST, reason: fmt.Sprintf("finished %s", ordinal(position))}
+	}
+
+	won := outcome{
+		status:   betting.Leg_WON,
+		dividend: odds * float64(paid) / float64(sharing),
+		reason:   fmt.Sprintf("finished %s", ordinal(position)),
+	}
+
+	if sharing > 1 {
+		won.reason = fmt.Sprintf("dead heat of %d for %s, %d of %d paid", sharing, ordinal(position), paid, sharing)
+	}
+
+	return won
+}
+
+// abandoned is the outcome of every selection on an abandoned race.
+var abandoned = outcome{status: betting.Leg_VOID, dividend: 1, reason: "race abandoned"}
+
+// deleted is the outcome of every selection on a deleted race.
+var deleted = outcome{status: betting.Leg_VOID, dividend: 1, reason: "race deleted"}
+
+// settleSingle settles a WIN or PLACE bet given the outcome of its runner.
+// Bets on void selections are refunded.
+func settleSingle(bet *betting.Bet, o outcome) db.Settlement {
+	settlement := db.Settlement{BetID: bet.Id, RaceID: bet.RaceId, Reason: o.reason}
+
+	switch o.status {
+	case betting.Leg_WON:
+		settlement.Status = betting.Bet_WON
+		settlement.Payout = int64(math.Round(float64(bet.Stake) * o.dividend))
+	case betting.Leg_VOID:
+		settlement.Status = betting.Bet_REFUNDED
+		settlement.Payout = bet.Stake
+	default:
+		settlement.Status = betting.Bet_LOST
+	}
+
+	return settlement
+}
+
+// voidSameRace voids every outcome of the legs of a multi on a race once any
+// one of them is void, as legs on the same race are priced together, and the
+// rest cannot stand at their combined odds without it.
+func voidSameRace(outcomes map[int]outcome) {
+	if len(outcomes) < 2 {
+		return
+	}
+
+	for _, o := range outcomes {
+		if o.status != betting.Leg_VOID {
+			continue
+		}
+
+		for i := range outcomes {
+			if outcomes[i].status != betting.Leg_VOID {
+				outcomes[i] = outcome{status: betting.Leg_VOID, dividend: 1, reason: "same-race leg void: " + o.reason}
+			}
+		}
+
+		return
+	}
+}
+
+// settleMulti settles the legs of a multi on a race or event given their
+// outcomes, by index, and the multi itself should that decide it. A multi is
+// lost as soon as any leg is, and otherwise settled once no leg is pending:
+// refunded should every leg be void, and paid its stake times the dividends
+// of the races and events it has legs on otherwise. The multi is left
+// ACCEPTED while it is undecided, and once already lost. The caller sets the
+// race or event settled.
+func settleMulti(bet *betting.Bet, outcomes map[int]outcome) db.Settlement {
+	settlement := db.Settlement{
+		BetID:  bet.Id,
+		Status: betting.Bet_ACCEPTED,
+	}
+
+	for i := range bet.Legs {
+		if o, ok := outcomes[i]; ok {
+			settlement.Legs = append(settlement.Legs, db.LegSettlement{Number: i + 1, Status: o.status, Dividend: o.dividend})
+		}
+	}
+
+	if bet.Status != betting.Bet_ACCEPTED {
+		return settlement
+	}
+
+	var (
+		pending = false
+		void    = 0
+		groups  []group
+		byGroup = make(map[group][]*betting.Leg)
+	)
+
+	for i, leg := range bet.Legs {
+		settled := leg
+		if o, ok := outcomes[i]; ok {
+			settled = &betting.Leg{RaceId: leg.RaceId, EventId: leg.EventId, Odds: leg.Odds, SameRaceOdds: leg.SameRaceOdds, Status: o.status, Dividend: o.dividend}
+		}
+
+		switch settled.Status {
+		case betting.Leg_LOST:
+			settlement.Status = betting.Bet_LOST
+			settlement.Reason = fmt.Sprintf("leg %d lost", i+1)
+
+			if o, ok := outcomes[i]; ok {
+				settlement.Reason += ": " + o.reason
+			}
+
+			return settlement
+		case betting.Leg_PENDING:
+			pending = true
+		case betting.Leg_VOID:
+			void++
+		}
+
+		key := group{leg.RaceId, leg.EventId}
+
+		if _, ok := byGroup[key]; !ok {
+			groups = append(groups, key)
+		}
+
+		byGroup[key] = append(byGroup[key], settled)
+	}
+
+	switch {
+	case pending:
+		return settlement
+	case void == len(bet.Legs):
+		settlement.Status = betting.Bet_REFUNDED
+		settlement.Payout = bet.Stake
+		settlement.Reason = "every leg void"
+
+		return settlement
+	}
+
+	dividend := 1.0
+
+	for _, key := range groups {
+		dividend *= sameRaceDividend(byGroup[key])
+	}
+
+	settlement.Status = betting.Bet_WON
+	settlement.Payout = int64(math.Round(float64(bet.Stake) * dividend))
+	settlement.Reason = fmt.Sprintf("%d of %d legs won, %d void", len(bet.Legs)-void, len(bet.Legs), void)
+
+	return settlement
+}
+
+// group is the race, or sports event, legs of a multi are on. Legs on the
+// same race are priced together, and each leg on an event is alone on it.
+type group struct{ raceID, eventID int64 }
+
+// sameRaceDividend returns the dividend of the settled legs of a multi on a
+// race, none of them lost. A leg alone on its race carries its own dividend.
+// Legs won together carry their same-race odds, reduced in proportion to any
+// of their dividends reduced by a dead heat, and legs void carry 1.
+func sameRaceDividend(legs []*betting.Leg) float64 {
+	if len(legs) == 1 {
+		return legs[0].Dividend
+	}
+
+	dividend := legs[0].SameRaceOdds
+
+	for _, leg := range legs {
+		if leg.Status == betting.Leg_VOID {
+			return 1
+		}
+
+		dividend *= leg.Dividend / leg.Odds
+	}
+
+	return dividend
+}
+
+func min(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+// ordinal formats a finishing position, such as 1st or 22nd.
+func ordinal(position int64) string {
+	suffix := "th"
+
+	switch position % 10 {
+	case 1:
+		suffix = "st"
+	case 2:
+		suffix = "nd"
+	case 3:
+		suffix = "rd"
+	}
+
+	if position%100 >= 11 && position%100 <= 13 {
+		suffix = "th"
+	}
+
+	return fmt.Sprintf("%d%s", position, suffix)
+}
@@ -0,0 +1,208 @@
+package settlement
+
+import (
+	"testing"
+
+	"git.neds.sh/matty/entain/betting/proto/betting"
+	"git.neds.sh/matty/entain/betting/proto/racing"
+)
+
+func TestSettleResulted(t *testing.T) {
+	// Runner 1 won outright, 2 and 3 dead heated for second, and 4, 5 and 6
+	// dead heated for fourth. Runner 9 was scratched.
+	result := &racing.RaceResult{Placings: []*racing.Placing{
+		{RunnerId: 1, Position: 1},
+		{RunnerId: 2, Position: 2, DeadHeat: true},
+		{RunnerId: 3, Position: 2, DeadHeat: true},
+		{RunnerId: 4, Position: 4, DeadHeat: true},
+		{RunnerId: 5, Position: 4, DeadHeat: true},
+		{RunnerId: 6, Position: 4, DeadHeat: true},
+	}}
+	scratched := map[int64]bool{9: true}
+
+	deadHeatForFirst := &racing.RaceResult{Placings: []*racing.Placing{
+		{RunnerId: 1, Position: 1, DeadHeat: true},
+		{RunnerId: 2, Position: 1, DeadHeat: true},
+		{RunnerId: 3, Position: 3},
+	}}
+
+	for _, tc := range []struct {
+		name       string
+		runnerID   int64
+		places     int64
+		result     *racing.RaceResult
+		wantStatus betting.Bet_Status
+		wantPayout int64
+	}{
+		{"win", 1, 1, result, betting.Bet_WON, 4000},
+		{"second on a win bet", 2, 1, result, betting.Bet_LOST, 0},
+		{"unplaced", 7, 3, result, betting.Bet_LOST, 0},
+		{"scratched", 9, 1, result, betting.Bet_REFUNDED, 1000},
+		{"place", 1, 3, result, betting.Bet_WON, 4000},
+		// Second and third are both paid, so the dead heat costs nothing.
+		{"dead heat within the places", 3, 3, result, betting.Bet_WON, 4000},
+		// Only second is paid, so half the stake is paid.
+		{"dead heat for the last place paid", 2, 2, result, betting.Bet_WON, 2000},
+		// Fourth to sixth are not paid on three places.
+		{"dead heat outside the places", 4, 3, result, betting.Bet_LOST, 0},
+		{"win dead heat", 2, 1, deadHeatForFirst, betting.Bet_WON, 2000},
+		{"place after a dead heat for first", 3, 2, deadHeatForFirst, betting.Bet_LOST, 0},
+		{"place after a dead heat for first with three paid", 3, 3, deadHeatForFirst, betting.Bet_WON, 4000},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			bet := &betting.Bet{Id: 1, RunnerId: tc.runnerID, Stake: 1000, Odds: 4, Places: tc.places}
+
+			got := settleSingle(bet, resolve(bet.RunnerId, bet.Places, bet.Odds, tc.result, scratched))
+			if got.BetID != bet.Id || got.Status != tc.wantStatus || got.Payout != tc.wantPayout {
+				t.Errorf("settled as %s paying %d, want %s paying %d", got.Status, got.Payout, tc.wantStatus, tc.wantPayout)
+			}
+
+			if got.Reason == "" {
+				t.Error("settled without a reason")
+			}
+		})
+	}
+}
+
+func TestSettleAbandoned(t *testing.T) {
+	got := settleSingle(&betting.Bet{Id: 3, Stake: 250, Odds: 9}, abandoned)
+	if got.BetID != 3 || got.Status != betting.Bet_REFUNDED || got.Payout != 250 {
+		t.Errorf("settled %d as %s paying %d, want 3 as REFUNDED paying 250", got.BetID, got.Status, got.Payout)
+	}
+}
+
+func TestSettleMulti(t *testing.T) {
+	won := outcome{status: betting.Leg_WON, dividend: 3, reason: "finished 1st"}
+	lost := outcome{status: betting.Leg_LOST, reason: "finished 5th"}
+
+	// A multi of legs on races 1, 2 and 3, at odds of 2, 3 and 4.
+	multi := func(statuses ...betting.Leg_Status) *betting.Bet {
+		bet := &betting.Bet{Id: 1, Type: betting.Bet_MULTI, Stake: 100, Status: betting.Bet_ACCEPTED}
+
+		for i, status := range statuses {
+			leg := &betting.Leg{RaceId: int64(i + 1), Odds: float64(i + 2), Status: status}
+			if status == betting.Leg_WON {
+				leg.Dividend = leg.Odds
+			} else if status == betting.Leg_VOID {
+				leg.Dividend = 1
+			}
+
+			bet.Legs = append(bet.Legs, leg)
+		}
+
+		return bet
+	}
+
+	pending, wonLeg, void := betting.Leg_PENDING, betting.Leg_WON, betting.Leg_VOID
+
+	for _, tc := range []struct {
+		name       string
+		bet        *betting.Bet
+		raceID     int64
+		outcome    outcome
+		wantStatus betting.Bet_Status
+		wantPayout int64
+	}{
+		{"first leg won", multi(pending, pending, pending), 1, won, betting.Bet_ACCEPTED, 0},
+		{"first leg lost", multi(pending, pending, pending), 1, lost, betting.Bet_LOST, 0},
+		{"last leg won", multi(wonLeg, pending, wonLeg), 2, won, betting.Bet_WON, 2400},
+		{"last leg won after a void", multi(wonLeg, void, pending), 3, outcome{status: betting.Leg_WON, dividend: 4}, betting.Bet_WON, 800},
+		// Dead heats reduce a leg's dividend, and so the multi's return.
+		{"last leg dead heat", multi(wonLeg, wonLeg, pending), 3, outcome{status: betting.Leg_WON, dividend: 2}, betting.Bet_WON, 1200},
+		{"last leg void", multi(wonLeg, wonLeg, pending), 3, abandoned, betting.Bet_WON, 600},
+		{"every leg void", multi(void, void, pending), 3, abandoned, betting.Bet_REFUNDED, 100},
+		{"last leg lost", multi(wonLeg, wonLeg, pending), 3, lost, betting.Bet_LOST, 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := settleMulti(tc.bet, map[int]outcome{int(tc.raceID) - 1: tc.outcome})
+			if got.Status != tc.wantStatus || got.Payout != tc.wantPayout {
+				t.Errorf("settled as %s paying %d, want %s paying %d", got.Status, got.Payout, tc.wantStatus, tc.wantPayout)
+			}
+
+			if len(got.Legs) != 1 || got.Legs[0].Number != int(tc.raceID) || got.Legs[0].Status != tc.outcome.status {
+				t.Errorf("settled legs %v, want the leg on race %d %s", got.Legs, tc.raceID, tc.outcome.status)
+			}
+		})
+	}
+
+	// A multi already lost still has its remaining legs settled.
+	lostMulti := multi(betting.Leg_LOST, pending, pending)
+	lostMulti.Status = betting.Bet_LOST
+
+	if got := settleMulti(lostMulti, map[int]outcome{1: won}); got.Status != betting.Bet_ACCEPTED || len(got.Legs) != 1 {
+		t.Errorf("settling a leg of a lost multi: got %s with legs %v, want only the leg settled", got.Status, got.Legs)
+	}
+}
+
+func TestSettleSameRaceMulti(t *testing.T) {
+	// A multi of a WIN leg at 4 and a PLACE leg at 2 on race 1, priced
+	// together at 6.5, and a WIN leg at 3 on race 2 already won.
+	multi := func() *betting.Bet {
+		return &betting.Bet{Id: 1, Type: betting.Bet_MULTI, Stake: 100, Status: betting.Bet_ACCEPTED, Legs: []*betting.Leg{
+			{RaceId: 1, RunnerId: 1, Type: betting.Bet_WIN, Odds: 4, Places: 1, SameRaceOdds: 6.5, Status: betting.Leg_PENDING},
+			{RaceId: 1, RunnerId: 2, Type: betting.Bet_PLACE, Odds: 2, Places: 3, SameRaceOdds: 6.5, Status: betting.Leg_PENDING},
+			{RaceId: 2, RunnerId: 5, Type: betting.Bet_WIN, Odds: 3, Places: 1, SameRaceOdds: 3, Status: betting.Leg_WON, Dividend: 3},
+		}}
+	}
+
+	won := func(dividend float64) outcome {
+		return outcome{status: betting.Leg_WON, dividend: dividend, reason: "finished 1st"}
+	}
+	lost := outcome{status: betting.Leg_LOST, reason: "finished 5th"}
+	scratched := outcome{status: betting.Leg_VOID, dividend: 1, reason: "runner scratched"}
+
+	for _, tc := range []struct {
+		name       string
+		outcomes   map[int]outcome
+		wantStatus betting.Bet_Status
+		wantPayout int64
+	}{
+		// Paid at the same-race odds, not the legs' odds multiplied.
+		{"both won", map[int]outcome{0: won(4), 1: won(2)}, betting.Bet_WON, 1950},
+		// A dead heat halving the place leg's dividend halves the race's.
+		{"dead heat", map[int]outcome{0: won(4), 1: won(1)}, betting.Bet_WON, 975},
+		{"one lost", map[int]outcome{0: won(4), 1: lost}, betting.Bet_LOST, 0},
+		// The race's legs stand or fall together, so a scratching voids both.
+		{"one scratched", map[int]outcome{0: won(4), 1: scratched}, betting.Bet_WON, 300},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			voidSameRace(tc.outcomes)
+
+			got := settleMulti(multi(), tc.outcomes)
+			if got.Status != tc.wantStatus || got.Payout != tc.wantPayout {
+				t.Errorf("settled as %s paying %d, want %s paying %d", got.Status, got.Payout, tc.wantStatus, tc.wantPayout)
+			}
+
+			if len(got.Legs) != 2 || got.Legs[0].Number != 1 || got.Legs[1].Number != 2 {
+				t.Errorf("settled legs %v, want legs 1 and 2", got.Legs)
+			}
+		})
+	}
+}
+
+func TestVoidSameRace(t *testing.T) {
+	won := outcome{status: betting.Leg_WON, dividend: 4, reason: "finished 1st"}
+	lost := outcome{status: betting.Leg_LOST, reason: "finished 5th"}
+	scratched := outcome{status: betting.Leg_VOID, dividend: 1, reason: "runner scratched"}
+
+	outcomes := map[int]outcome{0: won, 2: lost, 3: scratched}
+	voidSameRace(outcomes)
+
+	for i, o := range outcomes {
+		if o.status != betting.Leg_VOID || o.dividend != 1 {
+			t.Errorf("leg %d is %s at %v, want VOID at 1", i, o.status, o.dividend)
+		}
+	}
+
+	if got, want := outcomes[0].reason, "same-race leg void: runner scratched"; got != want {
+		t.Errorf("got reason %q, want %q", got, want)
+	}
+
+	// A leg alone on its race is left as it is.
+	alone := map[int]outcome{1: lost}
+	voidSameRace(alone)
+
+	if alone[1] != lost {
+		t.Errorf("voided a leg alone on its race: got %v", alone[1])
+	}
+}
@@ -0,0 +1,162 @@
+// Package settlement settles bets once the races, and sports events, they
+// were placed on are resulted or abandoned.
+package settlement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"git.neds.sh/matty/entain/betting/db"
+	"git.neds.sh/matty/entain/betting/proto/accounts"
+	"git.neds.sh/matty/entain/betting/proto/betting"
+	"git.neds.sh/matty/entain/betting/proto/racing"
+	"git.neds.sh/matty/entain/betting/proto/sports"
+)
+
+// ErrRaceNotFinal is returned when settling a race that is neither RESULTED
+// nor ABANDONED.
+var ErrRaceNotFinal = errors.New("race is not final")
+
+// Settler settles the bets on races, taking results and runners from the
+// racing service, and the legs on sports events, taking results from the
+// sports service, and pays them to the accounts they were placed from.
+type Settler struct {
+	betsRepo db.BetsRepo
+	races    racing.RacingClient
+	events   sports.SportsClient
+	wallet   accounts.AccountsClient
+
+	// mu serialises settlement, so that a multi whose last legs are settled
+	// at once is decided with every leg settled.
+	mu sync.Mutex
+
+	// payMu serialises payment, so that a settlement is not paid by two
+	// callers at once.
+	payMu sync.Mutex
+}
+
+// NewSettler instantiates and returns a new Settler.
+func NewSettler(betsRepo db.BetsRepo, races racing.RacingClient, events sports.SportsClient, wallet accounts.AccountsClient) *Settler {
+	return &Settler{betsRepo: betsRepo, races: races, events: events, wallet: wallet}
+}
+
+// Final reports whether bets on a race of the given status can be settled.
+func Final(raceStatus racing.Race_Status) bool {
+	return raceStatus == racing.Race_RESULTED || raceStatus == racing.Race_ABANDONED
+}
+
+// Settle settles the bets still open on a race, and the legs of multis on it,
+// returning the bets changed. The race must be RESULTED or ABANDONED. Bets
+// and legs already settled are left as they are, so a race can be settled
+// again, as when its result is seen twice, without paying any bet twice.
+//
+// The bets settled are then paid. Should paying fail, the bets remain
+// settled, and are paid by a later call to Pay.
+func (s *Settler) Settle(ctx context.Context, race *racing.Race) ([]*betting.Bet, error) {
+	if !Final(race.Status) {
+		return nil, fmt.Errorf("%w: race %d is %s, only RESULTED or ABANDONED races are settled", ErrRaceNotFinal, race.Id, race.Status)
+	}
+
+	return s.settle(ctx, race.Id, func(ctx context.Context) (resolveFunc, error) {
+		if race.Status == racing.Race_RESULTED {
+			return s.resolver(ctx, race.Id)
+		}
+
+		return func(runnerID, places int64, odds float64) outcome { return abandoned }, nil
+	})
+}
+
+// SettleDeleted settles the bets still open on a race that has been deleted,
+// and the legs of multis on it, as for an abandoned race: bets are refunded
+// and legs void. The race can no longer be looked up, so is given by ID.
+func (s *Settler) SettleDeleted(ctx context.Context, raceID int64) ([]*betting.Bet, error) {
+	return s.settle(ctx, raceID, func(ctx context.Context) (resolveFunc, error) {
+		return func(runnerID, places int64, odds float64) outcome { return deleted }, nil
+	})
+}
+
+// resolveFunc decides a selection of a runner, paying the given number of
+// places, at the given odds.
+type resolveFunc func(runnerID, places int64, odds float64) outcome
+
+// settle settles the bets still open on a race, resolving their selections
+// with the resolver returned by newResolver, which is only called should
+// there be bets to settle. The bets settled are then paid.
+func (s *Settler) settle(ctx context.Context, raceID int64, newResolver func(ctx context.Context) (resolveFunc, error)) ([]*betting.Bet, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	bets, err := s.betsRepo.ListOpen(ctx, raceID)
+	if err != nil || len(bets) == 0 {
+		return nil, err
+	}
+
+	resolveRunner, err := newResolver(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settlements := make([]db.Settlement, 0, len(bets))
+
+	for _, bet := range bets {
+		if bet.Type != betting.Bet_MULTI {
+			settlements = append(settlements, settleSingle(bet, resolveRunner(bet.RunnerId, bet.Places, bet.Odds)))
+			continue
+		}
+
+		outcomes := make(map[int]outcome)
+
+		for i, leg := range bet.Legs {
+			if leg.RaceId == raceID && leg.Status == betting.Leg_PENDING {
+				outcomes[i] = resolveRunner(leg.RunnerId, leg.Places, leg.Odds)
+			}
+		}
+
+		voidSameRace(outcomes)
+
+		settlement := settleMulti(bet, outcomes)
+		settlement.RaceID = raceID
+
+		settlements = append(settlements, settlement)
+	}
+
+	settled, err := s.betsRepo.Settle(ctx, settlements)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Pay(ctx); err != nil {
+		log.Printf("settlement: paying bets settled on race %d failed: %s\n", raceID, err)
+	}
+
+	return settled, nil
+}
+
+// resolver returns a function resolving the selections of runners on a
+// resulted race.
+func (s *Settler) resolver(ctx context.Context, raceID int64) (resolveFunc, error) {
+	result, err := s.races.GetRaceResult(ctx, &racing.GetRaceResultRequest{RaceId: raceID})
+	if err != nil {
+		return nil, err
+	}
+
+	runners, err := s.races.ListRunners(ctx, &racing.ListRunnersRequest{RaceId: raceID})
+	if err != nil {
+		return nil, err
+	}
+
+	scratched := make(map[int64]bool)
+
+	for _, runner := range runners.Runners {
+		if runner.Scratched {
+			scratched[runner.Id] = true
+		}
+	}
+
+	return func(runnerID, places int64, odds float64) outcome {
+		return resolve(runnerID, places, odds, result, scratched)
+	}, nil
+}
@@ -0,0 +1,160 @@
+package settlement
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"git.neds.sh/matty/entain/betting/db"
+	"git.neds.sh/matty/entain/betting/proto/betting"
+	"git.neds.sh/matty/entain/betting/proto/racing"
+	"google.golang.org/grpc"
+)
+
+// fakeRaceStream is a stream of race events that ends once they are sent.
+type fakeRaceStream struct {
+	grpc.ClientStream
+
+	events []*racing.RaceEvent
+}
+
+func (f *fakeRaceStream) Recv() (*racing.RaceEvent, error) {
+	if len(f.events) == 0 {
+		return nil, io.EOF
+	}
+
+	event := f.events[0]
+	f.events = f.events[1:]
+
+	return event, nil
+}
+
+// fakeRaces is a racing client whose watch sends a fixed stream of events.
+type fakeRaces struct {
+	racing.RacingClient
+
+	stream *fakeRaceStream
+}
+
+func (f *fakeRaces) WatchRaces(ctx context.Context, in *racing.WatchRacesRequest, opts ...grpc.CallOption) (racing.Racing_WatchRacesClient, error) {
+	return f.stream, nil
+}
+
+// openBets opens a bets repository holding a single bet on race 1, placed
+// with the stake reference "bet-a", and a multi with legs on races 1 and 2,
+// placed with "bet-b".
+func openBets(t *testing.T) (db.BetsRepo, *betting.Bet, *betting.Bet) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	bettingDB, err := db.Open(filepath.Join(t.TempDir(), "betting.db"))
+	if err != nil {
+		t.Fatalf("opening database: %s", err)
+	}
+	t.Cleanup(func() { _ = bettingDB.Close() })
+
+	repo := db.NewBetsRepo(bettingDB)
+	if err := repo.Init(ctx); err != nil {
+		t.Fatalf("initialising repository: %s", err)
+	}
+
+	limits := db.LiabilityLimits{Runner: 1 << 62, Race: 1 << 62, Event: 1 << 62}
+
+	single, err := repo.Create(ctx, &betting.Bet{
+		RaceId:          1,
+		RunnerId:        10,
+		Type:            betting.Bet_WIN,
+		Stake:           100,
+		Odds:            3,
+		Places:          1,
+		PotentialReturn: 300,
+		AccountId:       7,
+		StakeReference:  "bet-a",
+	}, limits)
+	if err != nil {
+		t.Fatalf("creating bet: %s", err)
+	}
+
+	multi, err := repo.Create(ctx, &betting.Bet{
+		Type:            betting.Bet_MULTI,
+		Stake:           100,
+		Odds:            6,
+		PotentialReturn: 600,
+		AccountId:       7,
+		StakeReference:  "bet-b",
+		Legs: []*betting.Leg{
+			{RaceId: 1, RunnerId: 11, Type: betting.Bet_WIN, Odds: 2, Places: 1, SameRaceOdds: 2},
+			{RaceId: 2, RunnerId: 20, Type: betting.Bet_WIN, Odds: 3, Places: 1, SameRaceOdds: 3},
+		},
+	}, limits)
+	if err != nil {
+		t.Fatalf("creating multi: %s", err)
+	}
+
+	return repo, single, multi
+}
+
+func TestSettleDeleted(t *testing.T) {
+	ctx := context.Background()
+	repo, single, multi := openBets(t)
+	wallet := &fakeWallet{}
+	settler := NewSettler(repo, nil, nil, wallet)
+
+	settled, err := settler.SettleDeleted(ctx, 1)
+	if err != nil {
+		t.Fatalf("settling deleted race: %s", err)
+	}
+
+	if len(settled) != 2 {
+		t.Fatalf("settled %d bets, want 2", len(settled))
+	}
+
+	// The single bet is refunded, and the multi's leg on the race void,
+	// leaving it open on race 2.
+	if got := settled[0]; got.Id != single.Id || got.Status != betting.Bet_REFUNDED || got.Payout != 100 {
+		t.Errorf("single bet %d settled as %s paying %d, want bet %d REFUNDED paying 100", got.Id, got.Status, got.Payout, single.Id)
+	}
+
+	if got := settled[1]; got.Id != multi.Id || got.Status != betting.Bet_ACCEPTED || got.Legs[0].Status != betting.Leg_VOID || got.Legs[1].Status != betting.Leg_PENDING {
+		t.Errorf("multi %d settled as %s with legs %v, want bet %d ACCEPTED with its first leg VOID", got.Id, got.Status, got.Legs, multi.Id)
+	}
+
+	if paid := wallet.references(); len(paid) != 1 || paid[0] != "bet-a" {
+		t.Errorf("paid %v, want the refund of bet-a", paid)
+	}
+
+	if settled, err := settler.SettleDeleted(ctx, 1); err != nil || len(settled) != 0 {
+		t.Errorf("settling deleted race again: settled %v, err = %v, want none", settled, err)
+	}
+}
+
+func TestWatcherSettlesDeletedRaces(t *testing.T) {
+	ctx := context.Background()
+	repo, single, _ := openBets(t)
+
+	races := &fakeRaces{stream: &fakeRaceStream{events: []*racing.RaceEvent{
+		{Type: racing.RaceEvent_SYNCED, Sequence: 1},
+		{Type: racing.RaceEvent_DELETED, Sequence: 2, Race: &racing.Race{Id: 1, Status: racing.Race_OPEN}},
+	}}}
+	watcher := NewWatcher(races, NewSettler(repo, races, nil, &fakeWallet{}))
+
+	var sequence int64
+	if synced, err := watcher.watch(ctx, &sequence); !synced || err != io.EOF {
+		t.Fatalf("watching: synced = %t, err = %v, want synced until the stream ended", synced, err)
+	}
+
+	if sequence != 2 {
+		t.Errorf("watched up to sequence %d, want 2", sequence)
+	}
+
+	got, err := repo.Get(ctx, single.Id)
+	if err != nil {
+		t.Fatalf("getting bet: %s", err)
+	}
+
+	if got.Status != betting.Bet_REFUNDED {
+		t.Errorf("bet on deleted race is %s, want REFUNDED", got.Status)
+	}
+}
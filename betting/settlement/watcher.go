@@ -0,0 +1,117 @@
+package settlement
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"git.neds.sh/matty/entain/betting/proto/racing"
+)
+
+const (
+	// minRetryDelay is the delay before rewatching races after the watch
+	// fails, doubling with each consecutive failure up to maxRetryDelay.
+	minRetryDelay = time.Second
+	maxRetryDelay = 30 * time.Second
+)
+
+// Watcher settles bets as the races they were placed on are resulted or
+// abandoned, by watching races on the racing service.
+type Watcher struct {
+	races   racing.RacingClient
+	settler *Settler
+}
+
+// NewWatcher instantiates and returns a new Watcher.
+func NewWatcher(races racing.RacingClient, settler *Settler) *Watcher {
+	return &Watcher{races: races, settler: settler}
+}
+
+// Run watches races until the context is done, settling the bets on every
+// race that is or becomes RESULTED or ABANDONED, and refunding those on
+// races deleted. The snapshot sent when the
+// watch starts settles races finalised while we were not watching. Should
+// the watch fail it is resumed after the last event handled, so that a race
+// whose bets failed to settle is seen again.
+func (w *Watcher) Run(ctx context.Context) {
+	var (
+		sequence int64
+		delay    = minRetryDelay
+	)
+
+	for {
+		synced, err := w.watch(ctx, &sequence)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if synced {
+			delay = minRetryDelay
+		}
+
+		log.Printf("settlement: watching races failed, retrying in %s: %s\n", delay, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		if delay *= 2; delay > maxRetryDelay {
+			delay = maxRetryDelay
+		}
+	}
+}
+
+// watch watches races after the given sequence until the stream fails,
+// advancing the sequence past each event handled, and reports whether the
+// watch got as far as being synced.
+func (w *Watcher) watch(ctx context.Context, sequence *int64) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := w.races.WatchRaces(ctx, &racing.WatchRacesRequest{ResumeAfter: *sequence})
+	if err != nil {
+		return false, err
+	}
+
+	synced := false
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return synced, err
+		}
+
+		switch event.Type {
+		case racing.RaceEvent_SYNCED:
+			synced = true
+		case racing.RaceEvent_DELETED:
+			bets, err := w.settler.SettleDeleted(ctx, event.Race.GetId())
+			if err != nil {
+				return synced, err
+			}
+
+			if len(bets) > 0 {
+				log.Printf("settlement: settled %d bets on deleted race %d\n", len(bets), event.Race.GetId())
+			}
+		default:
+			if Final(event.Race.GetStatus()) {
+				bets, err := w.settler.Settle(ctx, event.Race)
+				if err != nil {
+					return synced, err
+				}
+
+				if len(bets) > 0 {
+					log.Printf("settlement: settled %d bets on %s race %d\n", len(bets), event.Race.Status, event.Race.Id)
+				}
+			}
+		}
+
+		// Snapshot events all carry the same sequence, so the sequence only
+		// advances once the whole snapshot has been handled.
+		if event.Type != racing.RaceEvent_SNAPSHOT {
+			*sequence = event.Sequence
+		}
+	}
+}
@@ -0,0 +1,1103 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// postgresDSNEnv names the environment variable holding the DSN of a
+// PostgreSQL database to run the conformance suite against. The suite drops
+// and recreates the races schema, so it must not point at real data.
+const postgresDSNEnv = "RACING_TEST_POSTGRES_DSN"
+
+// conformanceNow is the frozen time race statuses are derived relative to.
+var conformanceNow = time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// testRepos are repositories sharing a store.
+type testRepos struct {
+	races    RacesRepo
+	meetings MeetingsRepo
+	runners  RunnersRepo
+	prices   PricesRepo
+}
+
+// reposFactory creates empty, initialised repositories whose clock is frozen
+// at conformanceNow.
+type reposFactory func(t *testing.T) testRepos
+
+func TestSQLiteRacesRepoConformance(t *testing.T) {
+	testRacesRepoConformance(t, func(t *testing.T) testRepos {
+		return openTestRepos(t, filepath.Join(t.TempDir(), "racing.db"))
+	})
+}
+
+func TestMemoryRacesRepoConformance(t *testing.T) {
+	testRacesRepoConformance(t, newMemoryTestRepos)
+}
+
+func TestPostgresRacesRepoConformance(t *testing.T) {
+	dsn := os.Getenv(postgresDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", postgresDSNEnv)
+	}
+
+	testRacesRepoConformance(t, func(t *testing.T) testRepos {
+		return openTestRepos(t, dsn)
+	})
+}
+
+// openTestRepos opens the database named by dsn, reverting every applied
+// migration so that the repositories start empty.
+func openTestRepos(t *testing.T, dsn string) testRepos {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, dialect, err := Open(dsn)
+	if err != nil {
+		t.Fatalf("opening %s: %s", dsn, err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	migrator, err := NewMigrator(db, dialect)
+	if err != nil {
+		t.Fatalf("creating migrator: %s", err)
+	}
+
+	for {
+		if _, err := migrator.Down(ctx); errors.Is(err, ErrNoMigrations) {
+			break
+		} else if err != nil {
+			t.Fatalf("reverting migrations: %s", err)
+		}
+	}
+
+	opts := []RepoOption{WithDialect(dialect), WithClock(func() time.Time { return conformanceNow })}
+
+	repos := testRepos{
+		races:    NewRacesRepo(db, opts...),
+		meetings: NewMeetingsRepo(db, opts...),
+		runners:  NewRunnersRepo(db, opts...),
+		prices:   NewPricesRepo(db, opts...),
+	}
+
+	for _, repo := range []interface{ Init(context.Context) error }{repos.races, repos.meetings, repos.runners, repos.prices} {
+		if err := repo.Init(ctx); err != nil {
+			t.Fatalf("initialising repository: %s", err)
+		}
+	}
+
+	return repos
+}
+
+// newMemoryTestRepos creates empty in-memory repositories.
+func newMemoryTestRepos(t *testing.T) testRepos {
+	meetings := NewMemoryMeetingsRepo()
+	races := NewMemoryRacesRepo(meetings, WithClock(func() time.Time { return conformanceNow }))
+
+	runners := NewMemoryRunnersRepo(races)
+
+	return testRepos{
+		races:    races,
+		meetings: meetings,
+		runners:  runners,
+		prices:   NewMemoryPricesRepo(runners, WithClock(func() time.Time { return conformanceNow })),
+	}
+}
+
+// conformanceMeetings are created, in order, by createConformanceMeetings,
+// taking IDs 1 to 3 which the conformance races reference.
+var conformanceMeetings = []*racing.Meeting{
+	{Name: "Flemington", Venue: "Flemington", Country: "AU", RaceType: racing.Meeting_THOROUGHBRED, Date: "2021-03-01", TrackCondition: "Good 4", Weather: "Fine"},
+	{Name: "Addington", Venue: "Addington", Country: "NZ", RaceType: racing.Meeting_HARNESS, Date: "2021-03-01", TrackCondition: "Fast", Weather: "Overcast"},
+	{Name: "Wentworth Park", Venue: "Wentworth Park", Country: "AU", RaceType: racing.Meeting_GREYHOUND, Date: "2021-02-28", TrackCondition: "Good", Weather: "Showers"},
+}
+
+// createConformanceMeetings creates the conformance meetings, returning their
+// IDs in the same order.
+func createConformanceMeetings(t *testing.T, repo MeetingsRepo) []int64 {
+	t.Helper()
+
+	ids := make([]int64, 0, len(conformanceMeetings))
+
+	for _, meeting := range conformanceMeetings {
+		created, err := repo.Create(context.Background(), meeting)
+		if err != nil {
+			t.Fatalf("creating meeting: %s", err)
+		}
+
+		ids = append(ids, created.Id)
+	}
+
+	return ids
+}
+
+// conformanceRaces are created, in order, by createConformanceRaces. Races 0
+// and 3 share a start time and name, and names differ in case, to check ties
+// and collation agree across implementations.
+var conformanceRaces = []*racing.Race{
+	{MeetingId: 1, Name: "Alpha", Number: 3, Visible: true, AdvertisedStartTime: timestamppb.New(conformanceNow.Add(time.Hour))},
+	{MeetingId: 2, Name: "bravo", Number: 1, Visible: false, AdvertisedStartTime: timestamppb.New(conformanceNow.Add(-time.Hour))},
+	{MeetingId: 1, Name: "Charlie", Number: 2, Visible: true, AdvertisedStartTime: timestamppb.New(conformanceNow.Add(2 * time.Hour))},
+	{MeetingId: 3, Name: "Alpha", Number: 1, Visible: false, AdvertisedStartTime: timestamppb.New(conformanceNow.Add(time.Hour))},
+	{MeetingId: 2, Name: "Delta", Number: 2, Visible: true, AdvertisedStartTime: timestamppb.New(conformanceNow.Add(-2 * time.Hour))},
+}
+
+// createConformanceRaces creates the conformance races, returning their IDs
+// in the same order.
+func createConformanceRaces(t *testing.T, repo RacesRepo) []int64 {
+	t.Helper()
+
+	ids := make([]int64, 0, len(conformanceRaces))
+
+	for _, race := range conformanceRaces {
+		created, err := repo.Create(context.Background(), race)
+		if err != nil {
+			t.Fatalf("creating race: %s", err)
+		}
+
+		ids = append(ids, created.Id)
+	}
+
+	return ids
+}
+
+// conformanceRunners returns runners of the first two of the conformance races
+// with the given IDs. The first two runners share a race, in reverse order of
+// number.
+func conformanceRunners(ids []int64) []*racing.Runner {
+	return []*racing.Runner{
+		{RaceId: ids[0], Number: 2, Barrier: 1, Name: "Winx", Jockey: "Hugh Bowman", Trainer: "Chris Waller", Weight: 57.5, Form: "1x11"},
+		{RaceId: ids[0], Number: 1, Barrier: 4, Name: "Black Caviar", Jockey: "Luke Nolen", Trainer: "Peter Moody", Weight: 58, Scratched: true, Form: "111"},
+		{RaceId: ids[1], Number: 1, Barrier: 2, Name: "Makybe Diva", Jockey: "Glen Boss", Trainer: "Lee Freedman", Weight: 55.5},
+	}
+}
+
+// listAllMeetings lists every meeting matching the request, following page
+// tokens, and returns their IDs in the order listed.
+func listAllMeetings(t *testing.T, repo MeetingsRepo, in *racing.ListMeetingsRequest) []int64 {
+	t.Helper()
+
+	var ids []int64
+
+	for page := 0; ; page++ {
+		if page > len(conformanceMeetings) {
+			t.Fatalf("listing did not terminate")
+		}
+
+		meetings, next, err := repo.List(context.Background(), in)
+		if err != nil {
+			t.Fatalf("listing meetings: %s", err)
+		}
+
+		for _, meeting := range meetings {
+			ids = append(ids, meeting.Id)
+		}
+
+		if next == "" {
+			return ids
+		}
+
+		in.PageToken = next
+	}
+}
+
+// listAll lists every race matching the request, following page tokens, and
+// returns their IDs in the order listed.
+func listAll(t *testing.T, repo RacesRepo, in *racing.ListRacesRequest) []int64 {
+	t.Helper()
+
+	var ids []int64
+
+	for page := 0; ; page++ {
+		if page > len(conformanceRaces) {
+			t.Fatalf("listing did not terminate")
+		}
+
+		races, next, err := repo.List(context.Background(), in)
+		if err != nil {
+			t.Fatalf("listing races: %s", err)
+		}
+
+		for _, race := range races {
+			ids = append(ids, race.Id)
+		}
+
+		if next == "" {
+			return ids
+		}
+
+		in.PageToken = next
+	}
+}
+
+// pick returns the IDs at the given indexes.
+func pick(ids []int64, indexes ...int) []int64 {
+	picked := make([]int64, 0, len(indexes))
+
+	for _, i := range indexes {
+		picked = append(picked, ids[i])
+	}
+
+	return picked
+}
+
+// testRacesRepoConformance checks a races repository behaves as every
+// implementation must, so that the in-memory repository can stand in for the
+// SQL one in tests.
+func testRacesRepoConformance(t *testing.T, newRepos reposFactory) {
+	ctx := context.Background()
+
+	// Most tests only need the races repository, with the meetings its races
+	// are held at.
+	newRepo := func(t *testing.T) RacesRepo {
+		repos := newRepos(t)
+		createConformanceMeetings(t, repos.meetings)
+
+		return repos.races
+	}
+
+	t.Run("create and get", func(t *testing.T) {
+		repo := newRepo(t)
+
+		created, err := repo.Create(ctx, conformanceRaces[0])
+		if err != nil {
+			t.Fatalf("creating race: %s", err)
+		}
+
+		if created.Id <= 0 {
+			t.Errorf("created race has ID %d, want a positive ID", created.Id)
+		}
+
+		got, err := repo.Get(ctx, created.Id)
+		if err != nil {
+			t.Fatalf("getting race: %s", err)
+		}
+
+		want := proto.Clone(conformanceRaces[0]).(*racing.Race)
+		want.Id = created.Id
+		want.Status = racing.Race_OPEN
+
+		if !proto.Equal(got, want) {
+			t.Errorf("got race %v, want %v", got, want)
+		}
+	})
+
+	t.Run("get missing race", func(t *testing.T) {
+		repo := newRepo(t)
+
+		if _, err := repo.Get(ctx, 42); !errors.Is(err, ErrRaceNotFound) {
+			t.Errorf("got error %v, want %v", err, ErrRaceNotFound)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		repo := newRepo(t)
+		ids := createConformanceRaces(t, repo)
+
+		updated, err := repo.Update(ctx, &racing.Race{
+			Id:                  ids[1],
+			Name:                "Echo",
+			MeetingId:           99,
+			AdvertisedStartTime: timestamppb.New(conformanceNow.Add(3 * time.Hour)),
+		}, []string{"name", "advertised_start_time"}, "")
+		if err != nil {
+			t.Fatalf("updating race: %s", err)
+		}
+
+		if updated.Name != "Echo" || updated.MeetingId != conformanceRaces[1].MeetingId {
+			t.Errorf("got race %v, want only its name and start time updated", updated)
+		}
+
+		if updated.Status != racing.Race_OPEN {
+			t.Errorf("got status %s, want %s", updated.Status, racing.Race_OPEN)
+		}
+
+		if _, err := repo.Update(ctx, &racing.Race{Id: 42, Name: "Echo"}, []string{"name"}, ""); !errors.Is(err, ErrRaceNotFound) {
+			t.Errorf("updating missing race: got error %v, want %v", err, ErrRaceNotFound)
+		}
+
+		if _, err := repo.Update(ctx, &racing.Race{Id: ids[1]}, []string{"id"}, ""); !errors.Is(err, ErrInvalidUpdateMask) {
+			t.Errorf("updating id: got error %v, want %v", err, ErrInvalidUpdateMask)
+		}
+
+		if _, err := repo.Update(ctx, &racing.Race{Id: ids[1], MeetingId: 99}, []string{"meeting_id"}, ""); !errors.Is(err, ErrMeetingNotFound) {
+			t.Errorf("updating to missing meeting: got error %v, want %v", err, ErrMeetingNotFound)
+		}
+	})
+
+	t.Run("create with missing meeting", func(t *testing.T) {
+		repo := newRepo(t)
+
+		race := proto.Clone(conformanceRaces[0]).(*racing.Race)
+		race.MeetingId = 99
+
+		if _, err := repo.Create(ctx, race); !errors.Is(err, ErrMeetingNotFound) {
+			t.Errorf("got error %v, want %v", err, ErrMeetingNotFound)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		repo := newRepo(t)
+		ids := createConformanceRaces(t, repo)
+
+		if err := repo.Delete(ctx, ids[2]); err != nil {
+			t.Fatalf("deleting race: %s", err)
+		}
+
+		if _, err := repo.Get(ctx, ids[2]); !errors.Is(err, ErrRaceNotFound) {
+			t.Errorf("getting deleted race: got error %v, want %v", err, ErrRaceNotFound)
+		}
+
+		if err := repo.Delete(ctx, ids[2]); !errors.Is(err, ErrRaceNotFound) {
+			t.Errorf("deleting deleted race: got error %v, want %v", err, ErrRaceNotFound)
+		}
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		repo := newRepo(t)
+		ids := createConformanceRaces(t, repo)
+
+		for _, test := range []struct {
+			name   string
+			filter *racing.ListRacesRequestFilter
+			want   []int64
+		}{
+			{"none", nil, pick(ids, 4, 1, 0, 3, 2)},
+			{"meeting ids", &racing.ListRacesRequestFilter{MeetingIds: []int64{1, 3}}, pick(ids, 0, 3, 2)},
+			{"visible", &racing.ListRacesRequestFilter{Visibility: racing.Visibility_VISIBILITY_VISIBLE}, pick(ids, 4, 0, 2)},
+			{"hidden", &racing.ListRacesRequestFilter{Visibility: racing.Visibility_VISIBILITY_HIDDEN}, pick(ids, 1, 3)},
+			{"open", &racing.ListRacesRequestFilter{Status: racing.Race_OPEN}, pick(ids, 0, 3, 2)},
+			{"closed", &racing.ListRacesRequestFilter{Status: racing.Race_CLOSED}, pick(ids, 4, 1)},
+			{"race types", &racing.ListRacesRequestFilter{
+				RaceTypes: []racing.Meeting_RaceType{racing.Meeting_THOROUGHBRED, racing.Meeting_GREYHOUND},
+			}, pick(ids, 0, 3, 2)},
+			{"venues", &racing.ListRacesRequestFilter{Venues: []string{"Addington"}}, pick(ids, 4, 1)},
+			{"combined", &racing.ListRacesRequestFilter{
+				MeetingIds: []int64{2, 3},
+				Visibility: racing.Visibility_VISIBILITY_HIDDEN,
+				Status:     racing.Race_OPEN,
+			}, pick(ids, 3)},
+			{"combined meeting", &racing.ListRacesRequestFilter{
+				RaceTypes: []racing.Meeting_RaceType{racing.Meeting_HARNESS, racing.Meeting_GREYHOUND},
+				Venues:    []string{"Flemington", "Wentworth Park"},
+				Status:    racing.Race_OPEN,
+			}, pick(ids, 3)},
+		} {
+			t.Run(test.name, func(t *testing.T) {
+				got := listAll(t, repo, &racing.ListRacesRequest{Filter: test.filter})
+				if !reflect.DeepEqual(got, test.want) {
+					t.Errorf("got races %v, want %v", got, test.want)
+				}
+			})
+		}
+	})
+
+	t.Run("statuses", func(t *testing.T) {
+		repo := newRepo(t)
+		ids := createConformanceRaces(t, repo)
+
+		races, _, err := repo.List(ctx, &racing.ListRacesRequest{})
+		if err != nil {
+			t.Fatalf("listing races: %s", err)
+		}
+
+		for _, race := range races {
+			want := racing.Race_OPEN
+			if race.Id == ids[1] || race.Id == ids[4] {
+				want = racing.Race_CLOSED
+			}
+
+			if race.Status != want {
+				t.Errorf("race %d has status %s, want %s", race.Id, race.Status, want)
+			}
+		}
+	})
+
+	t.Run("order and paginate", func(t *testing.T) {
+		repo := newRepo(t)
+		ids := createConformanceRaces(t, repo)
+
+		for _, test := range []struct {
+			orderBy string
+			want    []int64
+		}{
+			{"", pick(ids, 4, 1, 0, 3, 2)},
+			{"advertised_start_time desc", pick(ids, 2, 0, 3, 1, 4)},
+			{"name, number desc", pick(ids, 0, 3, 2, 4, 1)},
+			{"visible desc, meeting_id", pick(ids, 0, 2, 4, 1, 3)},
+			{"number desc, id desc", pick(ids, 0, 4, 2, 3, 1)},
+		} {
+			t.Run(test.orderBy, func(t *testing.T) {
+				for _, size := range []int32{1, 2, 100} {
+					got := listAll(t, repo, &racing.ListRacesRequest{OrderBy: test.orderBy, PageSize: size})
+					if !reflect.DeepEqual(got, test.want) {
+						t.Errorf("page size %d: got races %v, want %v", size, got, test.want)
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("page token mismatch", func(t *testing.T) {
+		repo := newRepo(t)
+		createConformanceRaces(t, repo)
+
+		_, next, err := repo.List(ctx, &racing.ListRacesRequest{PageSize: 2})
+		if err != nil {
+			t.Fatalf("listing races: %s", err)
+		}
+
+		for _, in := range []*racing.ListRacesRequest{
+			{PageToken: next, OrderBy: "name"},
+			{PageToken: next, Filter: &racing.ListRacesRequestFilter{MeetingIds: []int64{1}}},
+			{PageToken: "not a token"},
+		} {
+			if _, _, err := repo.List(ctx, in); !errors.Is(err, ErrInvalidPageToken) {
+				t.Errorf("listing %v: got error %v, want %v", in, err, ErrInvalidPageToken)
+			}
+		}
+	})
+
+	t.Run("seed", func(t *testing.T) {
+		repos := newRepos(t)
+		repo, meetings := repos.races, repos.meetings
+
+		opts := SeedOptions{Seed: 1, Meetings: 3, Races: 10, Runners: 4, From: conformanceNow.Add(-time.Hour), To: conformanceNow.Add(time.Hour)}
+
+		for i := 0; i < 2; i++ {
+			if err := meetings.Seed(ctx, opts); err != nil {
+				t.Fatalf("seeding meetings: %s", err)
+			}
+
+			if err := repo.Seed(ctx, opts); err != nil {
+				t.Fatalf("seeding: %s", err)
+			}
+
+			if err := repos.runners.Seed(ctx, opts); err != nil {
+				t.Fatalf("seeding runners: %s", err)
+			}
+		}
+
+		races, _, err := repo.List(ctx, &racing.ListRacesRequest{PageSize: 100})
+		if err != nil {
+			t.Fatalf("listing races: %s", err)
+		}
+
+		if len(races) != opts.Races {
+			t.Errorf("got %d races after seeding twice, want %d", len(races), opts.Races)
+		}
+
+		runners, err := repos.runners.List(ctx, []int64{races[0].Id})
+		if err != nil {
+			t.Fatalf("listing runners: %s", err)
+		}
+
+		if len(runners[races[0].Id]) != opts.Runners {
+			t.Errorf("got %d runners after seeding twice, want %d", len(runners[races[0].Id]), opts.Runners)
+		}
+
+		created, err := repo.Create(ctx, conformanceRaces[0])
+		if err != nil {
+			t.Fatalf("creating race after seeding: %s", err)
+		}
+
+		if created.Id != int64(opts.Races+1) {
+			t.Errorf("created race has ID %d, want %d", created.Id, opts.Races+1)
+		}
+
+		seeded, _, err := meetings.List(ctx, &racing.ListMeetingsRequest{PageSize: 100})
+		if err != nil {
+			t.Fatalf("listing meetings: %s", err)
+		}
+
+		if len(seeded) != opts.Meetings {
+			t.Errorf("got %d meetings after seeding twice, want %d", len(seeded), opts.Meetings)
+		}
+
+		meeting, err := meetings.Create(ctx, conformanceMeetings[0])
+		if err != nil {
+			t.Fatalf("creating meeting after seeding: %s", err)
+		}
+
+		if meeting.Id != int64(opts.Meetings+1) {
+			t.Errorf("created meeting has ID %d, want %d", meeting.Id, opts.Meetings+1)
+		}
+	})
+
+	t.Run("create and get meeting", func(t *testing.T) {
+		meetings := newRepos(t).meetings
+
+		created, err := meetings.Create(ctx, conformanceMeetings[0])
+		if err != nil {
+			t.Fatalf("creating meeting: %s", err)
+		}
+
+		got, err := meetings.Get(ctx, created.Id)
+		if err != nil {
+			t.Fatalf("getting meeting: %s", err)
+		}
+
+		want := proto.Clone(conformanceMeetings[0]).(*racing.Meeting)
+		want.Id = created.Id
+
+		if !proto.Equal(got, want) {
+			t.Errorf("got meeting %v, want %v", got, want)
+		}
+
+		if _, err := meetings.Get(ctx, 42); !errors.Is(err, ErrMeetingNotFound) {
+			t.Errorf("getting missing meeting: got error %v, want %v", err, ErrMeetingNotFound)
+		}
+	})
+
+	t.Run("list meetings", func(t *testing.T) {
+		meetings := newRepos(t).meetings
+		ids := createConformanceMeetings(t, meetings)
+
+		for _, test := range []struct {
+			name   string
+			filter *racing.ListMeetingsRequestFilter
+			want   []int64
+		}{
+			{"none", nil, pick(ids, 2, 0, 1)},
+			{"race types", &racing.ListMeetingsRequestFilter{
+				RaceTypes: []racing.Meeting_RaceType{racing.Meeting_HARNESS, racing.Meeting_GREYHOUND},
+			}, pick(ids, 2, 1)},
+			{"venues", &racing.ListMeetingsRequestFilter{Venues: []string{"Flemington"}}, pick(ids, 0)},
+			{"date", &racing.ListMeetingsRequestFilter{Date: "2021-03-01"}, pick(ids, 0, 1)},
+		} {
+			t.Run(test.name, func(t *testing.T) {
+				for _, size := range []int32{1, 2, 100} {
+					got := listAllMeetings(t, meetings, &racing.ListMeetingsRequest{Filter: test.filter, PageSize: size})
+					if !reflect.DeepEqual(got, test.want) {
+						t.Errorf("page size %d: got meetings %v, want %v", size, got, test.want)
+					}
+				}
+			})
+		}
+
+		_, next, err := meetings.List(ctx, &racing.ListMeetingsRequest{PageSize: 1})
+		if err != nil {
+			t.Fatalf("listing meetings: %s", err)
+		}
+
+		in := &racing.ListMeetingsRequest{PageToken: next, Filter: &racing.ListMeetingsRequestFilter{Date: "2021-03-01"}}
+		if _, _, err := meetings.List(ctx, in); !errors.Is(err, ErrInvalidPageToken) {
+			t.Errorf("listing %v: got error %v, want %v", in, err, ErrInvalidPageToken)
+		}
+	})
+
+	t.Run("runners", func(t *testing.T) {
+		repos := newRepos(t)
+		createConformanceMeetings(t, repos.meetings)
+		ids := createConformanceRaces(t, repos.races)
+
+		// Runners are created out of number order, which listing restores.
+		for _, runner := range conformanceRunners(ids) {
+			created, err := repos.runners.Create(ctx, runner)
+			if err != nil {
+				t.Fatalf("creating runner: %s", err)
+			}
+
+			if created.Id <= 0 {
+				t.Errorf("created runner has ID %d, want a positive ID", created.Id)
+			}
+		}
+
+		got, err := repos.runners.List(ctx, []int64{ids[0], ids[1], ids[2], 42})
+		if err != nil {
+			t.Fatalf("listing runners: %s", err)
+		}
+
+		if len(got) != 2 {
+			t.Errorf("got runners of %d races, want 2", len(got))
+		}
+
+		want := conformanceRunners(ids)
+
+		for _, test := range []struct {
+			got  []*racing.Runner
+			want []*racing.Runner
+		}{
+			{got[ids[0]], []*racing.Runner{want[1], want[0]}},
+			{got[ids[1]], []*racing.Runner{want[2]}},
+		} {
+			if len(test.got) != len(test.want) {
+				t.Fatalf("got runners %v, want %v", test.got, test.want)
+			}
+
+			for i := range test.want {
+				// IDs are assigned by the repository, so are not compared.
+				test.want[i].Id = test.got[i].Id
+
+				if !proto.Equal(test.got[i], test.want[i]) {
+					t.Errorf("got runner %v, want %v", test.got[i], test.want[i])
+				}
+			}
+		}
+
+		runner, err := repos.runners.Get(ctx, got[ids[1]][0].Id)
+		if err != nil {
+			t.Fatalf("getting runner: %s", err)
+		}
+
+		if !proto.Equal(runner, got[ids[1]][0]) {
+			t.Errorf("got runner %v, want %v", runner, got[ids[1]][0])
+		}
+
+		if _, err := repos.runners.Create(ctx, conformanceRunners(ids)[0]); !errors.Is(err, ErrDuplicateRunner) {
+			t.Errorf("creating runner with a taken number: got error %v, want %v", err, ErrDuplicateRunner)
+		}
+
+		missing := conformanceRunners(ids)[0]
+		missing.RaceId = 42
+
+		if _, err := repos.runners.Create(ctx, missing); !errors.Is(err, ErrRaceNotFound) {
+			t.Errorf("creating runner of a missing race: got error %v, want %v", err, ErrRaceNotFound)
+		}
+
+		if err := repos.races.Delete(ctx, ids[0]); err != nil {
+			t.Fatalf("deleting race: %s", err)
+		}
+
+		got, err = repos.runners.List(ctx, []int64{ids[0]})
+		if err != nil {
+			t.Fatalf("listing runners: %s", err)
+		}
+
+		if len(got) != 0 {
+			t.Errorf("got runners %v of a deleted race, want none", got)
+		}
+
+		for _, id := range []int64{want[0].Id, 42} {
+			if _, err := repos.runners.Get(ctx, id); !errors.Is(err, ErrRunnerNotFound) {
+				t.Errorf("getting runner %d: got error %v, want %v", id, err, ErrRunnerNotFound)
+			}
+		}
+	})
+
+	t.Run("prices", func(t *testing.T) {
+		repos := newRepos(t)
+		createConformanceMeetings(t, repos.meetings)
+		ids := createConformanceRaces(t, repos.races)
+
+		var runners []*racing.Runner
+
+		for _, runner := range conformanceRunners(ids) {
+			created, err := repos.runners.Create(ctx, runner)
+			if err != nil {
+				t.Fatalf("creating runner: %s", err)
+			}
+
+			runners = append(runners, created)
+		}
+
+		subscription := repos.prices.Subscribe(ids[0])
+		defer subscription.Cancel()
+
+		// Runner 0 is number 2 and runner 1 number 1 in the first race; runner 2
+		// is in the second race.
+		offered := []*racing.Price{
+			{RunnerId: runners[0].Id, Type: racing.Price_TOTE, Odds: 4.2},
+			{RunnerId: runners[0].Id, Type: racing.Price_FIXED, Odds: 3.5},
+			{RunnerId: runners[2].Id, Type: racing.Price_FIXED, Odds: 1.9},
+			{RunnerId: runners[1].Id, Type: racing.Price_FIXED, Odds: 6},
+			{RunnerId: runners[0].Id, Type: racing.Price_FIXED, Odds: 3.2},
+		}
+
+		for _, price := range offered {
+			recorded, err := repos.prices.Create(ctx, price)
+			if err != nil {
+				t.Fatalf("creating price: %s", err)
+			}
+
+			want := proto.Clone(price).(*racing.Price)
+			want.RaceId = ids[0]
+			if price.RunnerId == runners[2].Id {
+				want.RaceId = ids[1]
+			}
+			want.RecordedAt = timestamppb.New(conformanceNow)
+
+			if !proto.Equal(recorded, want) {
+				t.Errorf("got price %v, want %v", recorded, want)
+			}
+		}
+
+		recorded := func(i int, raceID int64) *racing.Price {
+			price := proto.Clone(offered[i]).(*racing.Price)
+			price.RaceId = raceID
+			price.RecordedAt = timestamppb.New(conformanceNow)
+
+			return price
+		}
+
+		got, err := repos.prices.List(ctx, ids[0])
+		if err != nil {
+			t.Fatalf("listing prices: %s", err)
+		}
+
+		want := []*racing.Price{recorded(3, ids[0]), recorded(1, ids[0]), recorded(4, ids[0]), recorded(0, ids[0])}
+
+		if len(got) != len(want) {
+			t.Fatalf("got prices %v, want %v", got, want)
+		}
+
+		for i := range want {
+			if !proto.Equal(got[i], want[i]) {
+				t.Errorf("got price %v, want %v", got[i], want[i])
+			}
+		}
+
+		// Only the prices of the race subscribed to are published, along with
+		// the prices they replace.
+		for _, want := range []PriceChange{
+			{Price: recorded(0, ids[0])},
+			{Price: recorded(1, ids[0])},
+			{Price: recorded(3, ids[0])},
+			{Price: recorded(4, ids[0]), Previous: recorded(1, ids[0])},
+		} {
+			select {
+			case change := <-subscription.Changes:
+				if !proto.Equal(change.Price, want.Price) || !proto.Equal(change.Previous, want.Previous) {
+					t.Errorf("got change %v from %v, want %v from %v", change.Price, change.Previous, want.Price, want.Previous)
+				}
+			default:
+				t.Fatalf("got no change, want %v", want.Price)
+			}
+		}
+
+		select {
+		case change := <-subscription.Changes:
+			t.Errorf("got unexpected change %v", change.Price)
+		default:
+		}
+
+		if _, err := repos.prices.Create(ctx, &racing.Price{RunnerId: 42, Type: racing.Price_FIXED, Odds: 2}); !errors.Is(err, ErrRunnerNotFound) {
+			t.Errorf("pricing missing runner: got error %v, want %v", err, ErrRunnerNotFound)
+		}
+
+		if err := repos.races.Delete(ctx, ids[0]); err != nil {
+			t.Fatalf("deleting race: %s", err)
+		}
+
+		if got, err := repos.prices.List(ctx, ids[0]); err != nil || len(got) != 0 {
+			t.Errorf("got prices %v, %v of a deleted race, want none", got, err)
+		}
+	})
+
+	t.Run("result", func(t *testing.T) {
+		repos := newRepos(t)
+		createConformanceMeetings(t, repos.meetings)
+		ids := createConformanceRaces(t, repos.races)
+
+		var runnerIDs []int64
+
+		for number := int64(1); number <= 3; number++ {
+			runner, err := repos.runners.Create(ctx, &racing.Runner{RaceId: ids[1], Number: number, Name: "Runner"})
+			if err != nil {
+				t.Fatalf("creating runner: %s", err)
+			}
+
+			runnerIDs = append(runnerIDs, runner.Id)
+		}
+
+		if _, err := repos.races.GetResult(ctx, ids[1]); !errors.Is(err, ErrResultNotFound) {
+			t.Errorf("getting result before resulting: got error %v, want %v", err, ErrResultNotFound)
+		}
+
+		// The last two runners dead heat for first.
+		result, err := repos.races.Result(ctx, &racing.RaceResult{RaceId: ids[1], Placings: []*racing.Placing{
+			{RunnerId: runnerIDs[0], Position: 3},
+			{RunnerId: runnerIDs[2], Position: 1},
+			{RunnerId: runnerIDs[1], Position: 1},
+		}}, "steward")
+		if err != nil {
+			t.Fatalf("resulting race: %s", err)
+		}
+
+		want := &racing.RaceResult{
+			RaceId: ids[1],
+			Placings: []*racing.Placing{
+				{RunnerId: runnerIDs[1], Position: 1, DeadHeat: true},
+				{RunnerId: runnerIDs[2], Position: 1, DeadHeat: true},
+				{RunnerId: runnerIDs[0], Position: 3},
+			},
+			ResultedAt: timestamppb.New(conformanceNow),
+		}
+
+		if !proto.Equal(result, want) {
+			t.Errorf("got result %v, want %v", result, want)
+		}
+
+		got, err := repos.races.GetResult(ctx, ids[1])
+		if err != nil {
+			t.Fatalf("getting result: %s", err)
+		}
+
+		if !proto.Equal(got, want) {
+			t.Errorf("got result %v, want %v", got, want)
+		}
+
+		race, err := repos.races.Get(ctx, ids[1])
+		if err != nil {
+			t.Fatalf("getting race: %s", err)
+		}
+
+		if race.Status != racing.Race_RESULTED {
+			t.Errorf("got status %s, want %s", race.Status, racing.Race_RESULTED)
+		}
+
+		for _, test := range []struct {
+			status racing.Race_Status
+			want   []int64
+		}{
+			{racing.Race_CLOSED, pick(ids, 4)},
+			{racing.Race_RESULTED, pick(ids, 1)},
+		} {
+			got := listAll(t, repos.races, &racing.ListRacesRequest{Filter: &racing.ListRacesRequestFilter{Status: test.status}})
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("listing %s races: got %v, want %v", test.status, got, test.want)
+			}
+		}
+
+		for _, id := range []int64{ids[1], ids[0]} {
+			if _, err := repos.races.Result(ctx, &racing.RaceResult{RaceId: id, Placings: want.Placings}, ""); !errors.Is(err, ErrInvalidTransition) {
+				t.Errorf("resulting race %d: got error %v, want %v", id, err, ErrInvalidTransition)
+			}
+		}
+
+		if _, err := repos.races.Result(ctx, &racing.RaceResult{RaceId: 42}, ""); !errors.Is(err, ErrRaceNotFound) {
+			t.Errorf("resulting missing race: got error %v, want %v", err, ErrRaceNotFound)
+		}
+	})
+
+	t.Run("status transitions", func(t *testing.T) {
+		repos := newRepos(t)
+		createConformanceMeetings(t, repos.meetings)
+		ids := createConformanceRaces(t, repos.races)
+
+		runner, err := repos.runners.Create(ctx, &racing.Runner{RaceId: ids[4], Number: 1, Name: "Runner"})
+		if err != nil {
+			t.Fatalf("creating runner: %s", err)
+		}
+
+		transition := func(id int64, status racing.Race_Status, actor string) error {
+			t.Helper()
+
+			race, err := repos.races.Update(ctx, &racing.Race{Id: id, Status: status}, []string{"status"}, actor)
+			if err == nil && race.Status != status {
+				t.Errorf("race %d moved to %s, want %s", id, race.Status, status)
+			}
+
+			return err
+		}
+
+		for _, step := range []struct {
+			id     int64
+			status racing.Race_Status
+			actor  string
+		}{
+			{ids[0], racing.Race_POSTPONED, "ops"},
+			{ids[0], racing.Race_OPEN, "ops"},
+			{ids[2], racing.Race_ABANDONED, "ops"},
+			{ids[2], racing.Race_ABANDONED, "ops"},
+			{ids[3], racing.Race_POSTPONED, "ops"},
+			{ids[4], racing.Race_INTERIM, "steward"},
+			{ids[4], racing.Race_PROTESTED, "steward"},
+		} {
+			if err := transition(step.id, step.status, step.actor); err != nil {
+				t.Fatalf("moving race %d to %s: %s", step.id, step.status, err)
+			}
+		}
+
+		if _, err := repos.races.Result(ctx, &racing.RaceResult{RaceId: ids[4], Placings: []*racing.Placing{
+			{RunnerId: runner.Id, Position: 1},
+		}}, "steward"); err != nil {
+			t.Fatalf("resulting protested race: %s", err)
+		}
+
+		for _, step := range []struct {
+			id     int64
+			status racing.Race_Status
+		}{
+			{ids[1], racing.Race_OPEN},
+			{ids[1], racing.Race_POSTPONED},
+			{ids[2], racing.Race_OPEN},
+			{ids[4], racing.Race_ABANDONED},
+			{ids[0], racing.Race_INTERIM},
+		} {
+			if err := transition(step.id, step.status, "ops"); !errors.Is(err, ErrInvalidTransition) {
+				t.Errorf("moving race %d to %s: got error %v, want %v", step.id, step.status, err, ErrInvalidTransition)
+			}
+		}
+
+		// A postponed race only reopens while its start time is to come.
+		if _, err := repos.races.Update(ctx, &racing.Race{
+			Id:                  ids[3],
+			Status:              racing.Race_OPEN,
+			AdvertisedStartTime: timestamppb.New(conformanceNow.Add(-time.Minute)),
+		}, []string{"status", "advertised_start_time"}, "ops"); !errors.Is(err, ErrInvalidTransition) {
+			t.Errorf("reopening race with a passed start time: got error %v, want %v", err, ErrInvalidTransition)
+		}
+
+		if _, err := repos.races.Update(ctx, &racing.Race{Id: 42, Status: racing.Race_ABANDONED}, []string{"status"}, "ops"); !errors.Is(err, ErrRaceNotFound) {
+			t.Errorf("moving missing race: got error %v, want %v", err, ErrRaceNotFound)
+		}
+
+		for _, test := range []struct {
+			status racing.Race_Status
+			want   []int64
+		}{
+			{racing.Race_OPEN, pick(ids, 0)},
+			{racing.Race_CLOSED, pick(ids, 1)},
+			{racing.Race_POSTPONED, pick(ids, 3)},
+			{racing.Race_ABANDONED, pick(ids, 2)},
+			{racing.Race_RESULTED, pick(ids, 4)},
+		} {
+			got := listAll(t, repos.races, &racing.ListRacesRequest{Filter: &racing.ListRacesRequestFilter{Status: test.status}})
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("listing %s races: got %v, want %v", test.status, got, test.want)
+			}
+		}
+
+		changedAt := timestamppb.New(conformanceNow)
+
+		for _, test := range []struct {
+			id   int64
+			want []*racing.RaceStatusChange
+		}{
+			{ids[0], []*racing.RaceStatusChange{
+				{RaceId: ids[0], From: racing.Race_OPEN, To: racing.Race_POSTPONED, Actor: "ops", ChangedAt: changedAt},
+				{RaceId: ids[0], From: racing.Race_POSTPONED, To: racing.Race_OPEN, Actor: "ops", ChangedAt: changedAt},
+			}},
+			{ids[1], nil},
+			{ids[2], []*racing.RaceStatusChange{
+				{RaceId: ids[2], From: racing.Race_OPEN, To: racing.Race_ABANDONED, Actor: "ops", ChangedAt: changedAt},
+			}},
+			{ids[4], []*racing.RaceStatusChange{
+				{RaceId: ids[4], From: racing.Race_CLOSED, To: racing.Race_INTERIM, Actor: "steward", ChangedAt: changedAt},
+				{RaceId: ids[4], From: racing.Race_INTERIM, To: racing.Race_PROTESTED, Actor: "steward", ChangedAt: changedAt},
+				{RaceId: ids[4], From: racing.Race_PROTESTED, To: racing.Race_RESULTED, Actor: "steward", ChangedAt: changedAt},
+			}},
+			{42, nil},
+		} {
+			got, err := repos.races.ListStatusHistory(ctx, test.id)
+			if err != nil {
+				t.Fatalf("listing status history of race %d: %s", test.id, err)
+			}
+
+			if len(got) != len(test.want) {
+				t.Errorf("race %d: got history %v, want %v", test.id, got, test.want)
+				continue
+			}
+
+			for i := range got {
+				if !proto.Equal(got[i], test.want[i]) {
+					t.Errorf("race %d: got change %v, want %v", test.id, got[i], test.want[i])
+				}
+			}
+		}
+
+		if err := repos.races.Delete(ctx, ids[0]); err != nil {
+			t.Fatalf("deleting race: %s", err)
+		}
+
+		if got, err := repos.races.ListStatusHistory(ctx, ids[0]); err != nil || len(got) != 0 {
+			t.Errorf("got history %v, %v of a deleted race, want none", got, err)
+		}
+	})
+
+	t.Run("concurrent updates", func(t *testing.T) {
+		repo := newRepo(t)
+
+		created, err := repo.Create(ctx, conformanceRaces[0])
+		if err != nil {
+			t.Fatalf("creating race: %s", err)
+		}
+
+		subscription := repo.Subscribe(0)
+		defer subscription.Cancel()
+
+		const updates = 10
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < updates; i++ {
+			wg.Add(1)
+
+			go func(i int) {
+				defer wg.Done()
+
+				if _, err := repo.Update(ctx, &racing.Race{Id: created.Id, Name: fmt.Sprintf("Echo %d", i)}, []string{"name"}, ""); err != nil {
+					t.Errorf("updating race: %s", err)
+				}
+			}(i)
+		}
+
+		wg.Wait()
+
+		// Each update replaced a different row, so the changes published
+		// chain from the race as created, each previous name appearing once.
+		var (
+			previous = make(map[string]int)
+			names    = map[string]bool{created.Name: true}
+		)
+
+		for i := 0; i < updates; i++ {
+			select {
+			case change := <-subscription.Changes:
+				previous[change.Previous.Name]++
+				names[change.Race.Name] = true
+			case <-time.After(time.Second):
+				t.Fatalf("got %d changes, want %d", i, updates)
+			}
+		}
+
+		for name, count := range previous {
+			if count != 1 || !names[name] {
+				t.Errorf("%d changes replaced a race named %q, want one replacing a name it had", count, name)
+			}
+		}
+
+		if len(previous) != updates {
+			t.Errorf("changes replaced %d distinct races, want %d", len(previous), updates)
+		}
+	})
+
+	t.Run("subscribe", func(t *testing.T) {
+		repo := newRepo(t)
+
+		subscription := repo.Subscribe(0)
+		defer subscription.Cancel()
+
+		created, err := repo.Create(ctx, conformanceRaces[0])
+		if err != nil {
+			t.Fatalf("creating race: %s", err)
+		}
+
+		select {
+		case change := <-subscription.Changes:
+			if change.Type != racing.RaceEvent_CREATED || change.Race.Id != created.Id {
+				t.Errorf("got %s change of race %d, want CREATED of race %d", change.Type, change.Race.Id, created.Id)
+			}
+
+			if change.Sequence <= subscription.Sequence {
+				t.Errorf("got change sequence %d, want one after %d", change.Sequence, subscription.Sequence)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("no change published")
+		}
+	})
+}
@@ -1,30 +1,132 @@
 package db
 
 import (
+	"context"
+	"errors"
+	"strconv"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"syreclabs.com/go/faker"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
 )
 
-func (r *racesRepo) seed() error {
-	statement, err := r.db.Prepare(`CREATE TABLE IF NOT EXISTS races (id INTEGER PRIMARY KEY, meeting_id INTEGER, name TEXT, number INTEGER, visible INTEGER, advertised_start_time DATETIME)`)
-	if err == nil {
-		_, err = statement.Exec()
-	}
-
-	for i := 1; i <= 100; i++ {
-		statement, err = r.db.Prepare(`INSERT OR IGNORE INTO races(id, meeting_id, name, number, visible, advertised_start_time) VALUES (?,?,?,?,?,?)`)
-		if err == nil {
-			_, err = statement.Exec(
-				i,
-				faker.Number().Between(1, 10),
-				faker.Team().Name(),
-				faker.Number().Between(1, 12),
-				faker.Number().Between(0, 1),
-				faker.Time().Between(time.Now().AddDate(0, 0, -1), time.Now().AddDate(0, 0, 2)).Format(time.RFC3339),
-			)
+// SeedOptions configures the dummy meetings, races and runners inserted by
+// Seed.
+type SeedOptions struct {
+	// Seed initialises the random generator; seeding with the same options
+	// always produces the same races.
+	Seed int64
+	// Meetings is the number of meetings to insert, with IDs from 1 upwards.
+	Meetings int
+	// Races is the number of races to insert, with IDs from 1 upwards, each
+	// held at one of the meetings.
+	Races int
+	// Runners is the number of runners to insert into each race.
+	Runners int
+	// From and To bound the dates of the meetings and the advertised start
+	// times of the races.
+	From, To time.Time
+}
+
+// validate checks the options describe something that can be seeded.
+func (opts SeedOptions) validate() error {
+	if opts.Meetings < 0 || opts.Races < 0 || opts.Runners < 0 || !opts.From.Before(opts.To) {
+		return errors.New("seed options must have non-negative counts and a non-empty time window")
+	}
+
+	if opts.Races > 0 && opts.Meetings == 0 {
+		return errors.New("seed options must have meetings to hold races at")
+	}
+
+	return nil
+}
+
+// Seed inserts dummy races for test/example purposes, in a single
+// transaction. Races that already exist are left untouched, so seeding is
+// safe to repeat. Meetings must be seeded first, with the same options.
+func (r *racesRepo) Seed(ctx context.Context, opts SeedOptions) error {
+	races, err := seedRaces(opts)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	statement, err := tx.PrepareContext(ctx, r.dialect.Rebind(r.dialect.InsertIgnore("races", []string{
+		"id", "meeting_id", "name", "number", "visible", "advertised_start_time",
+	})))
+	if err != nil {
+		return err
+	}
+	defer statement.Close()
+
+	for _, race := range races {
+		advertisedStart, err := raceUpdateColumns["advertised_start_time"](race)
+		if err != nil {
+			return err
 		}
+
+		if _, err := statement.ExecContext(
+			ctx,
+			race.Id,
+			race.MeetingId,
+			race.Name,
+			race.Number,
+			race.Visible,
+			advertisedStart,
+		); err != nil {
+			return err
+		}
+	}
+
+	// Explicitly inserted IDs do not advance an ID sequence, which must be
+	// moved past them before races are next created.
+	if sync := r.dialect.SyncSequence("races"); sync != "" {
+		if _, err := tx.ExecContext(ctx, sync); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// seedRaces generates the dummy races described by the options, with IDs
+// from 1 upwards. Start times are whole seconds, as stored.
+func seedRaces(opts SeedOptions) ([]*racing.Race, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	faker.Seed(opts.Seed)
+
+	races := make([]*racing.Race, 0, opts.Races)
+
+	for i := 1; i <= opts.Races; i++ {
+		meetingID, _ := strconv.ParseInt(faker.Number().Between(1, opts.Meetings), 10, 64)
+		name := faker.Team().Name()
+		number, _ := strconv.ParseInt(faker.Number().Between(1, 12), 10, 64)
+		visible := faker.Number().Between(0, 1) == "1"
+
+		advertisedStart, err := ptypes.TimestampProto(faker.Time().Between(opts.From, opts.To).Truncate(time.Second))
+		if err != nil {
+			return nil, err
+		}
+
+		races = append(races, &racing.Race{
+			Id:                  int64(i),
+			MeetingId:           meetingID,
+			Name:                name,
+			Number:              number,
+			Visible:             visible,
+			AdvertisedStartTime: advertisedStart,
+		})
 	}
 
-	return err
+	return races, nil
 }
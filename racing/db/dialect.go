@@ -0,0 +1,180 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Dialect adapts the repository's SQL, which is written for SQLite with ?
+// placeholders, to a database engine.
+type Dialect interface {
+	// Name identifies the dialect, and names the directory its migrations are
+	// read from.
+	Name() string
+
+	// Rebind rewrites the ? placeholders of a query into the dialect's own.
+	Rebind(query string) string
+
+	// Timestamp wraps a timestamp column or bound value so that values
+	// compare chronologically, whatever UTC offset they were written with.
+	Timestamp(expr string) string
+
+	// InsertIgnore returns a statement inserting a row into the table unless
+	// one with the same primary key already exists.
+	InsertIgnore(table string, columns []string) string
+
+	// InsertID executes an insert statement, returning the ID of the row
+	// inserted.
+	InsertID(ctx context.Context, db *sql.DB, query string, args ...interface{}) (int64, error)
+
+	// SyncSequence returns a statement moving the table's ID sequence past
+	// any IDs inserted explicitly, or "" if IDs are not drawn from a sequence.
+	SyncSequence(table string) string
+
+	// ForUpdate returns the clause locking the rows a query selects until its
+	// transaction ends, or "" if transactions already hold a lock covering
+	// them.
+	ForUpdate() string
+}
+
+var (
+	// SQLite is the dialect of SQLite, used for local development.
+	SQLite Dialect = sqliteDialect{}
+
+	// Postgres is the dialect of PostgreSQL.
+	Postgres Dialect = postgresDialect{}
+)
+
+// Open opens the database named by a DSN, returning the dialect to use with
+// it. DSNs starting with postgres:// or postgresql:// open PostgreSQL; any
+// other DSN is the path of a SQLite database, optionally prefixed by
+// sqlite3://.
+func Open(dsn string) (*sql.DB, Dialect, error) {
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		db, err := sql.Open("postgres", dsn)
+
+		return db, Postgres, err
+	}
+
+	path := strings.TrimPrefix(dsn, "sqlite3://")
+
+	// SQLite only enforces foreign keys when asked to. Transactions take the
+	// write lock as they begin, so that one reading a row it goes on to change
+	// waits for others writing rather than failing once they have.
+	for _, param := range []string{"_foreign_keys=on", "_txlock=immediate"} {
+		if strings.Contains(path, strings.SplitN(param, "=", 2)[0]+"=") {
+			continue
+		}
+
+		if strings.Contains(path, "?") {
+			path += "&" + param
+		} else {
+			path += "?" + param
+		}
+	}
+
+	db, err := sql.Open("sqlite3", path)
+
+	return db, SQLite, err
+}
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) Name() string {
+	return "sqlite"
+}
+
+func (sqliteDialect) Rebind(query string) string {
+	return query
+}
+
+// Timestamp normalises through datetime(), as timestamps are stored as text.
+func (sqliteDialect) Timestamp(expr string) string {
+	return "datetime(" + expr + ")"
+}
+
+func (sqliteDialect) InsertIgnore(table string, columns []string) string {
+	return "INSERT OR IGNORE INTO " + table + insertColumns(columns)
+}
+
+func (sqliteDialect) InsertID(ctx context.Context, db *sql.DB, query string, args ...interface{}) (int64, error) {
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.LastInsertId()
+}
+
+func (sqliteDialect) SyncSequence(table string) string {
+	return ""
+}
+
+// ForUpdate is empty, as SQLite transactions are opened with the database's
+// write lock already taken.
+func (sqliteDialect) ForUpdate() string {
+	return ""
+}
+
+type postgresDialect struct{}
+
+func (postgresDialect) Name() string {
+	return "postgres"
+}
+
+// Rebind numbers placeholders $1, $2 and so on. Queries must not contain ?
+// other than as placeholders.
+func (postgresDialect) Rebind(query string) string {
+	var (
+		rebound strings.Builder
+		n       int
+	)
+
+	for _, r := range query {
+		if r != '?' {
+			rebound.WriteRune(r)
+			continue
+		}
+
+		n++
+		rebound.WriteString("$" + strconv.Itoa(n))
+	}
+
+	return rebound.String()
+}
+
+// Timestamp casts to TIMESTAMPTZ, which is a no-op for columns of that type
+// and parses RFC 3339 text bound as a value.
+func (postgresDialect) Timestamp(expr string) string {
+	return "CAST(" + expr + " AS TIMESTAMPTZ)"
+}
+
+func (postgresDialect) InsertIgnore(table string, columns []string) string {
+	return "INSERT INTO " + table + insertColumns(columns) + " ON CONFLICT DO NOTHING"
+}
+
+func (postgresDialect) InsertID(ctx context.Context, db *sql.DB, query string, args ...interface{}) (int64, error) {
+	var id int64
+
+	err := db.QueryRowContext(ctx, query+" RETURNING id", args...).Scan(&id)
+
+	return id, err
+}
+
+func (postgresDialect) SyncSequence(table string) string {
+	return "SELECT setval(pg_get_serial_sequence('" + table + "', 'id'), COALESCE(MAX(id), 0) + 1, false) FROM " + table
+}
+
+func (postgresDialect) ForUpdate() string {
+	return " FOR UPDATE"
+}
+
+// insertColumns renders the column list and placeholders of an insert.
+func insertColumns(columns []string) string {
+	return "(" + strings.Join(columns, ", ") + ") VALUES (" + strings.Repeat("?,", len(columns)-1) + "?)"
+}
@@ -0,0 +1,358 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"syreclabs.com/go/faker"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// dateLayout formats meeting dates.
+const dateLayout = "2006-01-02"
+
+// meetingOrder is the order meetings are listed in, used to tie page tokens
+// to it.
+const meetingOrder = "date,id"
+
+// ErrMeetingNotFound is returned when a requested meeting does not exist,
+// including when a race references one.
+var ErrMeetingNotFound = errors.New("meeting not found")
+
+// MeetingsRepo provides repository access to meetings.
+type MeetingsRepo interface {
+	// Init will initialise our meetings repository.
+	Init(ctx context.Context) error
+
+	// Seed will insert dummy meetings for test/example purposes, which races
+	// seeded with the same options are held at.
+	Seed(ctx context.Context, opts SeedOptions) error
+
+	// List will return a page of meetings matching the request's filter,
+	// ordered by date, along with the token of the next page, if any.
+	List(ctx context.Context, in *racing.ListMeetingsRequest) ([]*racing.Meeting, string, error)
+
+	// Get will return a single meeting by its ID, or ErrMeetingNotFound.
+	Get(ctx context.Context, id int64) (*racing.Meeting, error)
+
+	// Create will insert a new meeting, returning it with its assigned ID.
+	Create(ctx context.Context, meeting *racing.Meeting) (*racing.Meeting, error)
+}
+
+type meetingsRepo struct {
+	store
+	init sync.Once
+}
+
+// NewMeetingsRepo creates a new meetings repository.
+func NewMeetingsRepo(db *sql.DB, opts ...RepoOption) MeetingsRepo {
+	return &meetingsRepo{store: newStore(db, opts)}
+}
+
+// Init migrates the database schema up to date.
+func (r *meetingsRepo) Init(ctx context.Context) error {
+	var err error
+
+	r.init.Do(func() {
+		err = r.migrate(ctx)
+	})
+
+	return err
+}
+
+func (r *meetingsRepo) Seed(ctx context.Context, opts SeedOptions) error {
+	meetings, err := seedMeetings(opts)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	statement, err := tx.PrepareContext(ctx, r.dialect.Rebind(r.dialect.InsertIgnore("meetings", []string{
+		"id", "name", "venue", "country", "race_type", "date", "track_condition", "weather",
+	})))
+	if err != nil {
+		return err
+	}
+	defer statement.Close()
+
+	for _, meeting := range meetings {
+		if _, err := statement.ExecContext(
+			ctx,
+			meeting.Id,
+			meeting.Name,
+			meeting.Venue,
+			meeting.Country,
+			meeting.RaceType.String(),
+			meeting.Date,
+			meeting.TrackCondition,
+			meeting.Weather,
+		); err != nil {
+			return err
+		}
+	}
+
+	if sync := r.dialect.SyncSequence("meetings"); sync != "" {
+		if _, err := tx.ExecContext(ctx, sync); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *meetingsRepo) List(ctx context.Context, in *racing.ListMeetingsRequest) ([]*racing.Meeting, string, error) {
+	var (
+		clauses []string
+		args    []interface{}
+	)
+
+	if in.PageToken != "" {
+		after, err := decodeToken(in.PageToken, meetingOrder, in.Filter, []valueKind{kindDate, kindInt})
+		if err != nil {
+			return nil, "", err
+		}
+
+		clauses = append(clauses, "(date > ? OR (date = ? AND id > ?))")
+		args = append(args, after[0], after[0], after[1])
+	}
+
+	filter := in.Filter
+	if filter == nil {
+		filter = &racing.ListMeetingsRequestFilter{}
+	}
+
+	if len(filter.RaceTypes) > 0 {
+		clauses = append(clauses, "race_type IN ("+strings.Repeat("?,", len(filter.RaceTypes)-1)+"?)")
+
+		for _, raceType := range filter.RaceTypes {
+			args = append(args, raceType.String())
+		}
+	}
+
+	if len(filter.Venues) > 0 {
+		clauses = append(clauses, "venue IN ("+strings.Repeat("?,", len(filter.Venues)-1)+"?)")
+
+		for _, venue := range filter.Venues {
+			args = append(args, venue)
+		}
+	}
+
+	if filter.Date != "" {
+		clauses = append(clauses, "date = ?")
+		args = append(args, filter.Date)
+	}
+
+	query := getMeetingQueries()[meetingsList]
+
+	if len(clauses) != 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+
+	size := pageSize(in.PageSize)
+
+	query += " ORDER BY date, id LIMIT ?"
+	args = append(args, size+1)
+
+	ctx, cancel := r.queryContext(ctx)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, r.dialect.Rebind(query), args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	meetings, err := scanMeetings(rows)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return meetingsPage(meetings, size, in.Filter)
+}
+
+func (r *meetingsRepo) Get(ctx context.Context, id int64) (*racing.Meeting, error) {
+	ctx, cancel := r.queryContext(ctx)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, r.dialect.Rebind(getMeetingQueries()[meetingsGet]), id)
+	if err != nil {
+		return nil, err
+	}
+
+	meetings, err := scanMeetings(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(meetings) == 0 {
+		return nil, ErrMeetingNotFound
+	}
+
+	return meetings[0], nil
+}
+
+func (r *meetingsRepo) Create(ctx context.Context, meeting *racing.Meeting) (*racing.Meeting, error) {
+	id, err := r.insert(ctx, getMeetingQueries()[meetingsCreate],
+		meeting.Name,
+		meeting.Venue,
+		meeting.Country,
+		meeting.RaceType.String(),
+		meeting.Date,
+		meeting.TrackCondition,
+		meeting.Weather,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, id)
+}
+
+// meetingsPage trims meetings fetched with one more than the page size to
+// the page, returning the token of the following page if there is one.
+func meetingsPage(meetings []*racing.Meeting, size int, filter *racing.ListMeetingsRequestFilter) ([]*racing.Meeting, string, error) {
+	if len(meetings) <= size {
+		return meetings, "", nil
+	}
+
+	meetings = meetings[:size]
+	last := meetings[size-1]
+
+	next, err := encodeToken(meetingOrder, filter, []interface{}{last.Date, last.Id})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return meetings, next, nil
+}
+
+func scanMeetings(rows *sql.Rows) ([]*racing.Meeting, error) {
+	defer rows.Close()
+
+	var meetings []*racing.Meeting
+
+	for rows.Next() {
+		var (
+			meeting  racing.Meeting
+			raceType string
+			date     time.Time
+		)
+
+		if err := rows.Scan(
+			&meeting.Id,
+			&meeting.Name,
+			&meeting.Venue,
+			&meeting.Country,
+			&raceType,
+			&date,
+			&meeting.TrackCondition,
+			&meeting.Weather,
+		); err != nil {
+			return nil, err
+		}
+
+		meeting.RaceType = racing.Meeting_RaceType(racing.Meeting_RaceType_value[raceType])
+		meeting.Date = date.Format(dateLayout)
+
+		meetings = append(meetings, &meeting)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return meetings, nil
+}
+
+// meetingMatchesFilter reports whether a meeting satisfies a filter,
+// mirroring the clauses applied when listing meetings.
+func meetingMatchesFilter(meeting *racing.Meeting, filter *racing.ListMeetingsRequestFilter) bool {
+	if filter == nil {
+		return true
+	}
+
+	return meetingMatches(meeting, filter.RaceTypes, filter.Venues) &&
+		(filter.Date == "" || meeting.Date == filter.Date)
+}
+
+// meetingMatches reports whether a meeting is of one of the race types, and
+// held at one of the venues, where either is restricted.
+func meetingMatches(meeting *racing.Meeting, raceTypes []racing.Meeting_RaceType, venues []string) bool {
+	if len(raceTypes) > 0 {
+		found := false
+
+		for _, raceType := range raceTypes {
+			if meeting.RaceType == raceType {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	if len(venues) > 0 {
+		found := false
+
+		for _, venue := range venues {
+			if meeting.Venue == venue {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// seedMeetings generates the dummy meetings described by the options, with
+// IDs from 1 upwards.
+func seedMeetings(opts SeedOptions) ([]*racing.Meeting, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	// Meetings draw from their own sequence, so races are seeded the same
+	// whether or not meetings are.
+	faker.Seed(opts.Seed)
+
+	meetings := make([]*racing.Meeting, 0, opts.Meetings)
+
+	for i := 1; i <= opts.Meetings; i++ {
+		venue := faker.Address().City()
+		raceType := racing.Meeting_RaceType(faker.RandomInt(1, 3))
+
+		trackConditions := []string{"Good", "Slow", "Fast"}
+		if raceType == racing.Meeting_THOROUGHBRED {
+			trackConditions = []string{"Firm 2", "Good 3", "Good 4", "Soft 5", "Soft 7", "Heavy 8", "Heavy 10"}
+		}
+
+		meetings = append(meetings, &racing.Meeting{
+			Id:             int64(i),
+			Name:           venue,
+			Venue:          venue,
+			Country:        faker.RandomChoice([]string{"AU", "NZ", "GB", "IE", "US"}),
+			RaceType:       raceType,
+			Date:           faker.Time().Between(opts.From, opts.To).UTC().Format(dateLayout),
+			TrackCondition: faker.RandomChoice(trackConditions),
+			Weather:        faker.RandomChoice([]string{"Fine", "Overcast", "Showers", "Raining"}),
+		})
+	}
+
+	return meetings, nil
+}
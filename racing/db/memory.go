@@ -0,0 +1,894 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// memoryRacesRepo is a races repository held in memory, for tests and local
+// runs that need no database. It filters, sorts and paginates races exactly
+// as racesRepo does, so the two are interchangeable, page tokens included.
+type memoryRacesRepo struct {
+	mu       sync.RWMutex
+	races    map[int64]*racing.Race
+	results  map[int64]*racing.RaceResult
+	history  map[int64][]*racing.RaceStatusChange
+	nextID   int64
+	meetings MeetingsRepo
+	clock    func() time.Time
+	notifier *changeNotifier
+}
+
+// NewMemoryRacesRepo creates a new, empty, in-memory races repository, whose
+// races are held at meetings of the given repository. Of the options, only
+// WithClock applies.
+func NewMemoryRacesRepo(meetings MeetingsRepo, opts ...RepoOption) RacesRepo {
+	config := newStore(nil, opts)
+
+	r := &memoryRacesRepo{
+		races:    make(map[int64]*racing.Race),
+		results:  make(map[int64]*racing.RaceResult),
+		history:  make(map[int64][]*racing.RaceStatusChange),
+		nextID:   1,
+		meetings: meetings,
+		clock:    config.clock,
+	}
+
+	r.notifier = newChangeNotifier(r.clock())
+	r.notifier.watchStarts(r.clock, r.startedBetween)
+
+	return r
+}
+
+// Init has nothing to prepare, as there is no schema.
+func (r *memoryRacesRepo) Init(ctx context.Context) error {
+	return nil
+}
+
+func (r *memoryRacesRepo) Seed(ctx context.Context, opts SeedOptions) error {
+	races, err := seedRaces(opts)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, race := range races {
+		if _, ok := r.races[race.Id]; ok {
+			continue
+		}
+
+		r.races[race.Id] = race
+
+		if race.Id >= r.nextID {
+			r.nextID = race.Id + 1
+		}
+	}
+
+	return nil
+}
+
+func (r *memoryRacesRepo) List(ctx context.Context, in *racing.ListRacesRequest) ([]*racing.Race, string, error) {
+	var after *pageCursor
+
+	order, err := parseOrderBy(in.OrderBy)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if in.PageToken != "" {
+		after, err = decodePageToken(in.PageToken, order, in.Filter)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, "", err
+	}
+
+	size := pageSize(in.PageSize)
+	now := r.clock()
+
+	r.mu.RLock()
+
+	var races []*racing.Race
+
+	for _, stored := range r.races {
+		race := r.withStatus(stored, now)
+
+		var meeting *racing.Meeting
+
+		if FilterNeedsMeeting(in.Filter) {
+			meeting, err = r.meetings.Get(ctx, race.MeetingId)
+			if err != nil && !errors.Is(err, ErrMeetingNotFound) {
+				r.mu.RUnlock()
+				return nil, "", err
+			}
+		}
+
+		if !RaceMatchesFilter(race, meeting, in.Filter) {
+			continue
+		}
+
+		if after != nil && compareRaces(race, after.fields, after.values) <= 0 {
+			continue
+		}
+
+		races = append(races, race)
+	}
+
+	r.mu.RUnlock()
+
+	sort.Slice(races, func(i, j int) bool {
+		return compareRaces(races[i], order, orderValues(races[j], order)) < 0
+	})
+
+	if len(races) <= size {
+		return races, "", nil
+	}
+
+	races = races[:size]
+
+	next, err := encodePageToken(order, in.Filter, races[size-1])
+	if err != nil {
+		return nil, "", err
+	}
+
+	return races, next, nil
+}
+
+func (r *memoryRacesRepo) Get(ctx context.Context, id int64) (*racing.Race, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored, ok := r.races[id]
+	if !ok {
+		return nil, ErrRaceNotFound
+	}
+
+	return r.withStatus(stored, r.clock()), nil
+}
+
+func (r *memoryRacesRepo) Create(ctx context.Context, race *racing.Race) (*racing.Race, error) {
+	stored := &racing.Race{}
+
+	if err := setRaceFields(stored, race, RaceUpdateFields()); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.meetings.Get(ctx, race.MeetingId); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+
+	stored.Id = r.nextID
+	r.nextID++
+	r.races[stored.Id] = stored
+
+	created := r.withStatus(stored, r.clock())
+
+	r.mu.Unlock()
+
+	r.notifier.publish(RaceChange{Type: racing.RaceEvent_CREATED, Race: created})
+
+	return created, nil
+}
+
+func (r *memoryRacesRepo) Update(ctx context.Context, race *racing.Race, fields []string, actor string) (*racing.Race, error) {
+	if err := checkRaceFields(fields); err != nil {
+		return nil, err
+	}
+
+	for _, field := range fields {
+		if field == "meeting_id" {
+			if _, err := r.meetings.Get(ctx, race.MeetingId); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	r.mu.Lock()
+
+	stored, ok := r.races[race.Id]
+	if !ok {
+		r.mu.Unlock()
+		return nil, ErrRaceNotFound
+	}
+
+	now := r.clock()
+	previous := r.withStatus(stored, now)
+
+	transitions := false
+	for _, field := range fields {
+		if field == raceStatusField && race.Status != previous.Status {
+			transitions = true
+		}
+	}
+
+	if transitions {
+		if err := checkStatusUpdate(previous, race, fields, now); err != nil {
+			r.mu.Unlock()
+			return nil, err
+		}
+	}
+
+	updated := proto.Clone(stored).(*racing.Race)
+	if err := setRaceFields(updated, race, fields); err != nil {
+		r.mu.Unlock()
+		return nil, err
+	}
+
+	if transitions {
+		updated.Status = memoryStoredStatus(race.Status)
+		r.recordTransition(race.Id, previous.Status, race.Status, actor, now)
+	}
+
+	r.races[race.Id] = updated
+
+	current := r.withStatus(updated, now)
+
+	r.mu.Unlock()
+
+	if len(fields) > 0 {
+		r.notifier.publish(RaceChange{Type: racing.RaceEvent_UPDATED, Race: current, Previous: previous})
+	}
+
+	return current, nil
+}
+
+func (r *memoryRacesRepo) Delete(ctx context.Context, id int64) error {
+	r.mu.Lock()
+
+	stored, ok := r.races[id]
+	if !ok {
+		r.mu.Unlock()
+		return ErrRaceNotFound
+	}
+
+	delete(r.races, id)
+	delete(r.results, id)
+	delete(r.history, id)
+
+	previous := r.withStatus(stored, r.clock())
+
+	r.mu.Unlock()
+
+	r.notifier.publish(RaceChange{Type: racing.RaceEvent_DELETED, Race: previous, Previous: previous})
+
+	return nil
+}
+
+func (r *memoryRacesRepo) Result(ctx context.Context, result *racing.RaceResult, actor string) (*racing.RaceResult, error) {
+	now := r.clock()
+
+	r.mu.Lock()
+
+	stored, ok := r.races[result.RaceId]
+	if !ok {
+		r.mu.Unlock()
+		return nil, ErrRaceNotFound
+	}
+
+	previous := r.withStatus(stored, now)
+	if err := checkTransition(result.RaceId, previous.Status, racing.Race_RESULTED); err != nil {
+		r.mu.Unlock()
+		return nil, err
+	}
+
+	stored.Status = racing.Race_RESULTED
+	r.recordTransition(result.RaceId, previous.Status, racing.Race_RESULTED, actor, now)
+
+	recorded := &racing.RaceResult{
+		RaceId:     result.RaceId,
+		Placings:   sortPlacings(result.Placings),
+		ResultedAt: timestamppb.New(now.Truncate(time.Second)),
+	}
+	r.results[result.RaceId] = recorded
+
+	resulted := r.withStatus(stored, now)
+
+	r.mu.Unlock()
+
+	r.notifier.publish(RaceChange{Type: racing.RaceEvent_STATUS_CHANGED, Race: resulted, Previous: previous})
+
+	return proto.Clone(recorded).(*racing.RaceResult), nil
+}
+
+func (r *memoryRacesRepo) GetResult(ctx context.Context, raceID int64) (*racing.RaceResult, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result, ok := r.results[raceID]
+	if !ok {
+		return nil, ErrResultNotFound
+	}
+
+	return proto.Clone(result).(*racing.RaceResult), nil
+}
+
+func (r *memoryRacesRepo) ListStatusHistory(ctx context.Context, raceID int64) ([]*racing.RaceStatusChange, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var changes []*racing.RaceStatusChange
+	for _, change := range r.history[raceID] {
+		changes = append(changes, proto.Clone(change).(*racing.RaceStatusChange))
+	}
+
+	return changes, nil
+}
+
+// recordTransition appends a status transition to the history of a race. The
+// caller must hold the lock.
+func (r *memoryRacesRepo) recordTransition(id int64, from, to racing.Race_Status, actor string, at time.Time) {
+	r.history[id] = append(r.history[id], &racing.RaceStatusChange{
+		RaceId:    id,
+		From:      from,
+		To:        to,
+		Actor:     actor,
+		ChangedAt: timestamppb.New(at.Truncate(time.Second)),
+	})
+}
+
+func (r *memoryRacesRepo) Subscribe(after int64) *RaceSubscription {
+	return r.notifier.subscribe(after)
+}
+
+// memoryMeetingsRepo is a meetings repository held in memory, the
+// counterpart of memoryRacesRepo.
+type memoryMeetingsRepo struct {
+	mu       sync.RWMutex
+	meetings map[int64]*racing.Meeting
+	nextID   int64
+}
+
+// NewMemoryMeetingsRepo creates a new, empty, in-memory meetings repository.
+func NewMemoryMeetingsRepo() MeetingsRepo {
+	return &memoryMeetingsRepo{meetings: make(map[int64]*racing.Meeting), nextID: 1}
+}
+
+// Init has nothing to prepare, as there is no schema.
+func (r *memoryMeetingsRepo) Init(ctx context.Context) error {
+	return nil
+}
+
+func (r *memoryMeetingsRepo) Seed(ctx context.Context, opts SeedOptions) error {
+	meetings, err := seedMeetings(opts)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, meeting := range meetings {
+		if _, ok := r.meetings[meeting.Id]; ok {
+			continue
+		}
+
+		r.meetings[meeting.Id] = meeting
+
+		if meeting.Id >= r.nextID {
+			r.nextID = meeting.Id + 1
+		}
+	}
+
+	return nil
+}
+
+func (r *memoryMeetingsRepo) List(ctx context.Context, in *racing.ListMeetingsRequest) ([]*racing.Meeting, string, error) {
+	var after []interface{}
+
+	if in.PageToken != "" {
+		var err error
+
+		after, err = decodeToken(in.PageToken, meetingOrder, in.Filter, []valueKind{kindDate, kindInt})
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	r.mu.RLock()
+
+	var meetings []*racing.Meeting
+
+	for _, meeting := range r.meetings {
+		if !meetingMatchesFilter(meeting, in.Filter) {
+			continue
+		}
+
+		if after != nil && compareMeeting(meeting, after[0].(string), after[1].(int64)) <= 0 {
+			continue
+		}
+
+		meetings = append(meetings, proto.Clone(meeting).(*racing.Meeting))
+	}
+
+	r.mu.RUnlock()
+
+	sort.Slice(meetings, func(i, j int) bool {
+		return compareMeeting(meetings[i], meetings[j].Date, meetings[j].Id) < 0
+	})
+
+	return meetingsPage(meetings, pageSize(in.PageSize), in.Filter)
+}
+
+func (r *memoryMeetingsRepo) Get(ctx context.Context, id int64) (*racing.Meeting, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	meeting, ok := r.meetings[id]
+	if !ok {
+		return nil, ErrMeetingNotFound
+	}
+
+	return proto.Clone(meeting).(*racing.Meeting), nil
+}
+
+func (r *memoryMeetingsRepo) Create(ctx context.Context, meeting *racing.Meeting) (*racing.Meeting, error) {
+	stored := proto.Clone(meeting).(*racing.Meeting)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored.Id = r.nextID
+	r.nextID++
+	r.meetings[stored.Id] = stored
+
+	return proto.Clone(stored).(*racing.Meeting), nil
+}
+
+// memoryRunnersRepo is a runners repository held in memory, the counterpart
+// of memoryRacesRepo.
+type memoryRunnersRepo struct {
+	mu      sync.RWMutex
+	runners map[int64][]*racing.Runner
+	ids     map[int64]bool
+	nextID  int64
+	races   RacesRepo
+}
+
+// NewMemoryRunnersRepo creates a new, empty, in-memory runners repository,
+// whose runners are entered in races of the given repository.
+func NewMemoryRunnersRepo(races RacesRepo) RunnersRepo {
+	return &memoryRunnersRepo{
+		runners: make(map[int64][]*racing.Runner),
+		ids:     make(map[int64]bool),
+		nextID:  1,
+		races:   races,
+	}
+}
+
+// Init has nothing to prepare, as there is no schema.
+func (r *memoryRunnersRepo) Init(ctx context.Context) error {
+	return nil
+}
+
+func (r *memoryRunnersRepo) Seed(ctx context.Context, opts SeedOptions) error {
+	runners, err := seedRunners(opts)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, runner := range runners {
+		if r.ids[runner.Id] || r.taken(runner.RaceId, runner.Number) {
+			continue
+		}
+
+		r.add(runner)
+
+		if runner.Id >= r.nextID {
+			r.nextID = runner.Id + 1
+		}
+	}
+
+	return nil
+}
+
+func (r *memoryRunnersRepo) List(ctx context.Context, raceIDs []int64) (map[int64][]*racing.Runner, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	runners := make(map[int64][]*racing.Runner)
+
+	for _, id := range raceIDs {
+		// Runners of deleted races are kept, but never listed, as race IDs are
+		// not reused.
+		if _, err := r.races.Get(ctx, id); err != nil {
+			if errors.Is(err, ErrRaceNotFound) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		for _, runner := range r.runners[id] {
+			runners[id] = append(runners[id], proto.Clone(runner).(*racing.Runner))
+		}
+	}
+
+	return runners, nil
+}
+
+func (r *memoryRunnersRepo) Get(ctx context.Context, id int64) (*racing.Runner, error) {
+	r.mu.RLock()
+
+	var found *racing.Runner
+
+	for _, runners := range r.runners {
+		for _, runner := range runners {
+			if runner.Id == id {
+				found = proto.Clone(runner).(*racing.Runner)
+			}
+		}
+	}
+
+	r.mu.RUnlock()
+
+	if found == nil {
+		return nil, ErrRunnerNotFound
+	}
+
+	// Runners of deleted races are kept, but never returned.
+	if _, err := r.races.Get(ctx, found.RaceId); err != nil {
+		if errors.Is(err, ErrRaceNotFound) {
+			return nil, ErrRunnerNotFound
+		}
+
+		return nil, err
+	}
+
+	return found, nil
+}
+
+func (r *memoryRunnersRepo) Create(ctx context.Context, runner *racing.Runner) (*racing.Runner, error) {
+	if _, err := r.races.Get(ctx, runner.RaceId); err != nil {
+		return nil, err
+	}
+
+	stored := proto.Clone(runner).(*racing.Runner)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.taken(runner.RaceId, runner.Number) {
+		return nil, ErrDuplicateRunner
+	}
+
+	stored.Id = r.nextID
+	r.nextID++
+	r.add(stored)
+
+	return proto.Clone(stored).(*racing.Runner), nil
+}
+
+// taken reports whether a race has a runner with the given number.
+func (r *memoryRunnersRepo) taken(raceID, number int64) bool {
+	for _, runner := range r.runners[raceID] {
+		if runner.Number == number {
+			return true
+		}
+	}
+
+	return false
+}
+
+// add stores a runner, keeping the runners of its race ordered by number.
+func (r *memoryRunnersRepo) add(runner *racing.Runner) {
+	runners := append(r.runners[runner.RaceId], runner)
+
+	sort.Slice(runners, func(i, j int) bool {
+		return runners[i].Number < runners[j].Number
+	})
+
+	r.runners[runner.RaceId] = runners
+	r.ids[runner.Id] = true
+}
+
+// compareMeeting compares a meeting's position in the meetings order with
+// the given date and ID.
+func compareMeeting(meeting *racing.Meeting, date string, id int64) int {
+	if c := compareValues(meeting.Date, date); c != 0 {
+		return c
+	}
+
+	return compareValues(meeting.Id, id)
+}
+
+// memoryStoredStatus returns the status held by a stored race, which is
+// unspecified for derived statuses, as the status column would be NULL.
+func memoryStoredStatus(status racing.Race_Status) racing.Race_Status {
+	if storedStatus(status) == nil {
+		return racing.Race_STATUS_UNSPECIFIED
+	}
+
+	return status
+}
+
+// withStatus returns a copy of a stored race with its status derived as of
+// now. Stored races are never handed out, so callers cannot modify them.
+func (r *memoryRacesRepo) withStatus(stored *racing.Race, now time.Time) *racing.Race {
+	race := proto.Clone(stored).(*racing.Race)
+
+	advertisedStart, err := ptypes.Timestamp(race.AdvertisedStartTime)
+	if err == nil {
+		race.Status = raceStatus(advertisedStart, now, stored.Status)
+	}
+
+	return race
+}
+
+// startedBetween returns the races whose advertised start time is after from
+// but not after to, and whose status is derived from it.
+func (r *memoryRacesRepo) startedBetween(from, to time.Time) ([]*racing.Race, error) {
+	r.mu.RLock()
+
+	var started []*racing.Race
+
+	for _, stored := range r.races {
+		advertisedStart, err := ptypes.Timestamp(stored.AdvertisedStartTime)
+		if err != nil || stored.Status != racing.Race_STATUS_UNSPECIFIED {
+			continue
+		}
+
+		if advertisedStart.After(from) && !advertisedStart.After(to) {
+			started = append(started, r.withStatus(stored, to))
+		}
+	}
+
+	r.mu.RUnlock()
+
+	order, _ := parseOrderBy(defaultRaceOrderBy)
+
+	sort.Slice(started, func(i, j int) bool {
+		return compareRaces(started[i], order, orderValues(started[j], order)) < 0
+	})
+
+	return started, nil
+}
+
+// setRaceFields copies the given fields from one race to another, as writing
+// them to the races table would, truncating the start time to whole seconds.
+func setRaceFields(dst, src *racing.Race, fields []string) error {
+	if err := checkRaceFields(fields); err != nil {
+		return err
+	}
+
+	for _, field := range fields {
+		switch field {
+		case "meeting_id":
+			dst.MeetingId = src.MeetingId
+		case "name":
+			dst.Name = src.Name
+		case "number":
+			dst.Number = src.Number
+		case "visible":
+			dst.Visible = src.Visible
+		case "advertised_start_time":
+			advertisedStart, err := ptypes.Timestamp(src.AdvertisedStartTime)
+			if err != nil {
+				return err
+			}
+
+			dst.AdvertisedStartTime, err = ptypes.TimestampProto(advertisedStart.Truncate(time.Second))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkRaceFields checks every field can be written. The status is written
+// by the caller, as it must be checked against the race's previous status.
+func checkRaceFields(fields []string) error {
+	for _, field := range fields {
+		if _, ok := raceUpdateColumns[field]; !ok && field != raceStatusField {
+			return fmt.Errorf("%w: field %q cannot be updated", ErrInvalidUpdateMask, field)
+		}
+	}
+
+	return nil
+}
+
+// orderValues returns a race's values of the given sort fields, as held in
+// page cursors.
+func orderValues(race *racing.Race, fields []orderField) []interface{} {
+	values := make([]interface{}, 0, len(fields))
+
+	for _, field := range fields {
+		values = append(values, raceOrderColumns[field.field].value(race))
+	}
+
+	return values
+}
+
+// compareRaces compares a race's sort values with the given ones
+// lexicographically, honouring each field's direction, returning a negative
+// number if the race sorts first, a positive one if it sorts after and zero
+// if the values are equal.
+func compareRaces(race *racing.Race, fields []orderField, values []interface{}) int {
+	for i, field := range fields {
+		c := compareValues(raceOrderColumns[field.field].value(race), values[i])
+		if field.desc {
+			c = -c
+		}
+
+		if c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
+
+// compareValues compares two sort values of the same kind. Strings compare
+// bytewise, as SQLite's default collation does; start times are formatted as
+// RFC 3339 in UTC, which compares chronologically as strings.
+func compareValues(a, b interface{}) int {
+	switch a := a.(type) {
+	case int64:
+		b := b.(int64)
+
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+	case string:
+		b := b.(string)
+
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+	case bool:
+		b := b.(bool)
+
+		switch {
+		case !a && b:
+			return -1
+		case a && !b:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// memoryPricesRepo is a prices repository held in memory, the counterpart of
+// pricesRepo.
+type memoryPricesRepo struct {
+	mu       sync.Mutex
+	prices   map[int64][]*racing.Price
+	runners  RunnersRepo
+	clock    func() time.Time
+	notifier *priceNotifier
+}
+
+// NewMemoryPricesRepo creates a new, empty, in-memory prices repository,
+// whose prices are offered on runners of the given repository. Of the
+// options, only WithClock applies.
+func NewMemoryPricesRepo(runners RunnersRepo, opts ...RepoOption) PricesRepo {
+	config := newStore(nil, opts)
+
+	return &memoryPricesRepo{
+		prices:   make(map[int64][]*racing.Price),
+		runners:  runners,
+		clock:    config.clock,
+		notifier: newPriceNotifier(),
+	}
+}
+
+// Init has nothing to prepare, as there is no schema.
+func (r *memoryPricesRepo) Init(ctx context.Context) error {
+	return nil
+}
+
+func (r *memoryPricesRepo) Seed(ctx context.Context, opts SeedOptions) error {
+	prices, err := seedPrices(opts)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Prices have no ID of their own here, so seeding is kept safe to repeat
+	// by leaving runners that are already priced untouched.
+	priced := make(map[int64]bool, len(r.prices))
+	for runnerID := range r.prices {
+		priced[runnerID] = true
+	}
+
+	for _, price := range prices {
+		if !priced[price.RunnerId] {
+			r.prices[price.RunnerId] = append(r.prices[price.RunnerId], price)
+		}
+	}
+
+	return nil
+}
+
+func (r *memoryPricesRepo) List(ctx context.Context, raceID int64) ([]*racing.Price, error) {
+	runners, err := r.runners.List(ctx, []int64{raceID})
+	if err != nil {
+		return nil, err
+	}
+
+	numbers := make(map[int64]int64, len(runners[raceID]))
+
+	r.mu.Lock()
+
+	var prices []*racing.Price
+
+	for _, runner := range runners[raceID] {
+		numbers[runner.Id] = runner.Number
+
+		for _, price := range r.prices[runner.Id] {
+			prices = append(prices, proto.Clone(price).(*racing.Price))
+		}
+	}
+
+	r.mu.Unlock()
+
+	sortPrices(prices, numbers)
+
+	return prices, nil
+}
+
+func (r *memoryPricesRepo) Create(ctx context.Context, price *racing.Price) (*racing.Price, error) {
+	runner, err := r.runners.Get(ctx, price.RunnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := &racing.Price{
+		RunnerId:   price.RunnerId,
+		RaceId:     runner.RaceId,
+		Type:       price.Type,
+		Odds:       price.Odds,
+		RecordedAt: timestamppb.New(r.clock().Truncate(time.Second)),
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var previous *racing.Price
+
+	for _, offered := range r.prices[price.RunnerId] {
+		if offered.Type == price.Type {
+			previous = proto.Clone(offered).(*racing.Price)
+		}
+	}
+
+	r.prices[price.RunnerId] = append(r.prices[price.RunnerId], recorded)
+
+	r.notifier.publish(PriceChange{Price: proto.Clone(recorded).(*racing.Price), Previous: previous})
+
+	return proto.Clone(recorded).(*racing.Price), nil
+}
+
+func (r *memoryPricesRepo) Subscribe(raceID int64) *PriceSubscription {
+	return r.notifier.subscribe(raceID)
+}
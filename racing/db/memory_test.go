@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// TestMemoryRacesRepoMatchesSQLite lists the same seeded races from both
+// repositories, checking every page and page token agree.
+func TestMemoryRacesRepoMatchesSQLite(t *testing.T) {
+	ctx := context.Background()
+
+	sqliteRepos := openTestRepos(t, filepath.Join(t.TempDir(), "racing.db"))
+	memoryRepos := newMemoryTestRepos(t)
+	sqlite, memory := sqliteRepos.races, memoryRepos.races
+
+	opts := SeedOptions{Seed: 7, Meetings: 10, Races: 150, Runners: 6, From: conformanceNow.AddDate(0, 0, -1), To: conformanceNow.AddDate(0, 0, 1)}
+
+	for _, meetings := range []MeetingsRepo{sqliteRepos.meetings, memoryRepos.meetings} {
+		if err := meetings.Seed(ctx, opts); err != nil {
+			t.Fatalf("seeding meetings: %s", err)
+		}
+	}
+
+	for _, repo := range []RacesRepo{sqlite, memory} {
+		if err := repo.Seed(ctx, opts); err != nil {
+			t.Fatalf("seeding: %s", err)
+		}
+	}
+
+	for _, runners := range []RunnersRepo{sqliteRepos.runners, memoryRepos.runners} {
+		if err := runners.Seed(ctx, opts); err != nil {
+			t.Fatalf("seeding runners: %s", err)
+		}
+	}
+
+	for _, prices := range []PricesRepo{sqliteRepos.prices, memoryRepos.prices} {
+		if err := prices.Seed(ctx, opts); err != nil {
+			t.Fatalf("seeding prices: %s", err)
+		}
+	}
+
+	filters := []*racing.ListRacesRequestFilter{
+		nil,
+		{MeetingIds: []int64{1, 4, 7}},
+		{Visibility: racing.Visibility_VISIBILITY_HIDDEN, Status: racing.Race_OPEN},
+		{Status: racing.Race_CLOSED},
+		{RaceTypes: []racing.Meeting_RaceType{racing.Meeting_HARNESS}},
+	}
+
+	orderBys := []string{"", "name", "visible desc, number, advertised_start_time desc", "meeting_id desc, id desc"}
+
+	for _, filter := range filters {
+		for _, orderBy := range orderBys {
+			in := &racing.ListRacesRequest{Filter: filter, OrderBy: orderBy, PageSize: 7}
+
+			for page := 0; ; page++ {
+				want, wantNext, err := sqlite.List(ctx, in)
+				if err != nil {
+					t.Fatalf("listing %v from SQLite: %s", in, err)
+				}
+
+				got, gotNext, err := memory.List(ctx, in)
+				if err != nil {
+					t.Fatalf("listing %v from memory: %s", in, err)
+				}
+
+				if len(got) != len(want) {
+					t.Fatalf("listing %v page %d: got %d races, want %d", in, page, len(got), len(want))
+				}
+
+				for i := range want {
+					if !proto.Equal(got[i], want[i]) {
+						t.Fatalf("listing %v page %d race %d: got %v, want %v", in, page, i, got[i], want[i])
+					}
+				}
+
+				if gotNext != wantNext {
+					t.Fatalf("listing %v page %d: got next page token %q, want %q", in, page, gotNext, wantNext)
+				}
+
+				if wantNext == "" {
+					break
+				}
+
+				in.PageToken = wantNext
+			}
+		}
+	}
+
+	raceIDs := make([]int64, 0, opts.Races)
+	for id := int64(1); id <= int64(opts.Races); id++ {
+		raceIDs = append(raceIDs, id)
+	}
+
+	want, err := sqliteRepos.runners.List(ctx, raceIDs)
+	if err != nil {
+		t.Fatalf("listing runners from SQLite: %s", err)
+	}
+
+	got, err := memoryRepos.runners.List(ctx, raceIDs)
+	if err != nil {
+		t.Fatalf("listing runners from memory: %s", err)
+	}
+
+	for _, id := range raceIDs {
+		if len(got[id]) != opts.Runners || len(want[id]) != opts.Runners {
+			t.Fatalf("race %d: got %d and %d runners, want %d", id, len(got[id]), len(want[id]), opts.Runners)
+		}
+
+		for i := range want[id] {
+			if !proto.Equal(got[id][i], want[id][i]) {
+				t.Fatalf("race %d runner %d: got %v, want %v", id, i, got[id][i], want[id][i])
+			}
+		}
+	}
+
+	for _, id := range raceIDs {
+		want, err := sqliteRepos.prices.List(ctx, id)
+		if err != nil {
+			t.Fatalf("listing prices from SQLite: %s", err)
+		}
+
+		got, err := memoryRepos.prices.List(ctx, id)
+		if err != nil {
+			t.Fatalf("listing prices from memory: %s", err)
+		}
+
+		if len(want) == 0 || len(got) != len(want) {
+			t.Fatalf("race %d: got %d prices, want %d, and at least one", id, len(got), len(want))
+		}
+
+		for i := range want {
+			if !proto.Equal(got[i], want[i]) {
+				t.Fatalf("race %d price %d: got %v, want %v", id, i, got[i], want[i])
+			}
+		}
+	}
+}
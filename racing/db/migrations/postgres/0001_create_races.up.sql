@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS races (
+	id BIGSERIAL PRIMARY KEY,
+	meeting_id BIGINT,
+	name TEXT COLLATE "C",
+	number BIGINT,
+	visible BOOLEAN,
+	advertised_start_time TIMESTAMPTZ
+);
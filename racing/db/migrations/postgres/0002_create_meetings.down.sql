@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS races_meeting_id;
+
+ALTER TABLE races DROP CONSTRAINT IF EXISTS races_meeting_id_fkey;
+
+DROP TABLE IF EXISTS meetings;
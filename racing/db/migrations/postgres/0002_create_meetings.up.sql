@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS meetings (
+	id BIGSERIAL PRIMARY KEY,
+	name TEXT COLLATE "C",
+	venue TEXT COLLATE "C",
+	country TEXT,
+	race_type TEXT,
+	date DATE,
+	track_condition TEXT,
+	weather TEXT
+);
+
+-- Races already referencing meetings are given placeholder meetings, dated by
+-- their earliest race, so that every race has a meeting to reference.
+INSERT INTO meetings(id, name, venue, country, race_type, date, track_condition, weather)
+SELECT meeting_id, 'Meeting ' || meeting_id, '', '', 'RACE_TYPE_UNSPECIFIED', CAST(MIN(advertised_start_time) AS DATE), '', ''
+FROM races
+WHERE meeting_id IS NOT NULL AND meeting_id NOT IN (SELECT id FROM meetings)
+GROUP BY meeting_id;
+
+SELECT setval(pg_get_serial_sequence('meetings', 'id'), COALESCE(MAX(id), 0) + 1, false) FROM meetings;
+
+ALTER TABLE races ADD CONSTRAINT races_meeting_id_fkey FOREIGN KEY (meeting_id) REFERENCES meetings(id);
+
+CREATE INDEX races_meeting_id ON races(meeting_id);
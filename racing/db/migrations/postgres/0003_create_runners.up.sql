@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS runners (
+	id BIGSERIAL PRIMARY KEY,
+	race_id BIGINT NOT NULL REFERENCES races(id) ON DELETE CASCADE,
+	number BIGINT,
+	barrier BIGINT,
+	name TEXT,
+	jockey TEXT,
+	trainer TEXT,
+	weight DOUBLE PRECISION,
+	scratched BOOLEAN,
+	form TEXT,
+	UNIQUE (race_id, number)
+);
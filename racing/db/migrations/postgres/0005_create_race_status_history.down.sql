@@ -0,0 +1 @@
+DROP TABLE IF EXISTS race_status_history;
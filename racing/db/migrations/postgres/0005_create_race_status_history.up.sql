@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS race_status_history (
+	id BIGSERIAL PRIMARY KEY,
+	race_id BIGINT NOT NULL REFERENCES races(id) ON DELETE CASCADE,
+	from_status TEXT NOT NULL,
+	to_status TEXT NOT NULL,
+	actor TEXT NOT NULL,
+	changed_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX race_status_history_race_id ON race_status_history(race_id);
+
+-- Races resulted before history was kept are recorded as resulted from CLOSED.
+INSERT INTO race_status_history(race_id, from_status, to_status, actor, changed_at)
+SELECT race_id, 'CLOSED', 'RESULTED', '', resulted_at FROM results;
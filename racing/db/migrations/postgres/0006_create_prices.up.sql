@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS prices (
+	id BIGSERIAL PRIMARY KEY,
+	runner_id BIGINT NOT NULL REFERENCES runners(id) ON DELETE CASCADE,
+	type TEXT NOT NULL,
+	odds DOUBLE PRECISION NOT NULL,
+	recorded_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX prices_runner_id ON prices(runner_id);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS races (
+	id INTEGER PRIMARY KEY,
+	meeting_id INTEGER,
+	name TEXT,
+	number INTEGER,
+	visible INTEGER,
+	advertised_start_time DATETIME
+);
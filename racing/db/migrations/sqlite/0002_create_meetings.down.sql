@@ -0,0 +1,17 @@
+CREATE TABLE races_without_meetings (
+	id INTEGER PRIMARY KEY,
+	meeting_id INTEGER,
+	name TEXT,
+	number INTEGER,
+	visible INTEGER,
+	advertised_start_time DATETIME
+);
+
+INSERT INTO races_without_meetings(id, meeting_id, name, number, visible, advertised_start_time)
+SELECT id, meeting_id, name, number, visible, advertised_start_time FROM races;
+
+DROP TABLE races;
+
+ALTER TABLE races_without_meetings RENAME TO races;
+
+DROP TABLE IF EXISTS meetings;
@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS meetings (
+	id INTEGER PRIMARY KEY,
+	name TEXT,
+	venue TEXT,
+	country TEXT,
+	race_type TEXT,
+	date DATE,
+	track_condition TEXT,
+	weather TEXT
+);
+
+-- Races already referencing meetings are given placeholder meetings, dated by
+-- their earliest race, so that every race has a meeting to reference.
+INSERT INTO meetings(id, name, venue, country, race_type, date, track_condition, weather)
+SELECT meeting_id, 'Meeting ' || meeting_id, '', '', 'RACE_TYPE_UNSPECIFIED', date(MIN(datetime(advertised_start_time))), '', ''
+FROM races
+WHERE meeting_id IS NOT NULL AND meeting_id NOT IN (SELECT id FROM meetings)
+GROUP BY meeting_id;
+
+-- SQLite cannot add a foreign key to an existing table, so races is rebuilt.
+CREATE TABLE races_with_meetings (
+	id INTEGER PRIMARY KEY,
+	meeting_id INTEGER REFERENCES meetings(id),
+	name TEXT,
+	number INTEGER,
+	visible INTEGER,
+	advertised_start_time DATETIME
+);
+
+INSERT INTO races_with_meetings(id, meeting_id, name, number, visible, advertised_start_time)
+SELECT id, meeting_id, name, number, visible, advertised_start_time FROM races;
+
+DROP TABLE races;
+
+ALTER TABLE races_with_meetings RENAME TO races;
+
+CREATE INDEX races_meeting_id ON races(meeting_id);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS runners (
+	id INTEGER PRIMARY KEY,
+	race_id INTEGER NOT NULL REFERENCES races(id) ON DELETE CASCADE,
+	number INTEGER,
+	barrier INTEGER,
+	name TEXT,
+	jockey TEXT,
+	trainer TEXT,
+	weight REAL,
+	scratched INTEGER,
+	form TEXT,
+	UNIQUE (race_id, number)
+);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS placings;
+DROP TABLE IF EXISTS results;
+
+ALTER TABLE races DROP COLUMN status;
@@ -0,0 +1,14 @@
+-- Races have a derived status, OPEN or CLOSED, unless one is stored.
+ALTER TABLE races ADD COLUMN status TEXT;
+
+CREATE TABLE IF NOT EXISTS results (
+	race_id INTEGER PRIMARY KEY REFERENCES races(id) ON DELETE CASCADE,
+	resulted_at DATETIME
+);
+
+CREATE TABLE IF NOT EXISTS placings (
+	race_id INTEGER NOT NULL REFERENCES results(race_id) ON DELETE CASCADE,
+	runner_id INTEGER NOT NULL REFERENCES runners(id) ON DELETE CASCADE,
+	position INTEGER NOT NULL,
+	PRIMARY KEY (race_id, runner_id)
+);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS prices (
+	id INTEGER PRIMARY KEY,
+	runner_id INTEGER NOT NULL REFERENCES runners(id) ON DELETE CASCADE,
+	type TEXT NOT NULL,
+	odds REAL NOT NULL,
+	recorded_at DATETIME NOT NULL
+);
+
+CREATE INDEX prices_runner_id ON prices(runner_id);
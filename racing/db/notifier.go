@@ -0,0 +1,319 @@
+package db
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+const (
+	// subscriberBuffer is the number of changes buffered per subscriber before
+	// it is considered to have fallen behind and is dropped.
+	subscriberBuffer = 256
+
+	// historySize is the number of recent changes retained for subscribers
+	// resuming after a disconnect.
+	historySize = 1024
+
+	// statusPollInterval is how often races are checked for having started
+	// while there are subscribers to race changes.
+	statusPollInterval = time.Second
+)
+
+// RaceChange describes a change made to a race, as published to subscribers of
+// the races repository.
+type RaceChange struct {
+	// Type of the change.
+	Type racing.RaceEvent_Type
+	// Race is the race after the change, or as it was before being deleted.
+	Race *racing.Race
+	// Previous is the race before the change, nil for created races.
+	Previous *racing.Race
+	// Sequence increases with every change published.
+	Sequence int64
+}
+
+// RaceSubscription is a subscription to changes made to races.
+type RaceSubscription struct {
+	// Changes receives changes as they are made. It is closed when the
+	// subscription is cancelled, or if the subscriber falls too far behind.
+	Changes <-chan RaceChange
+	// Sequence is the sequence of the last change made before subscribing.
+	Sequence int64
+	// Resumed reports whether the changes made since the sequence being
+	// resumed from were retained, in which case they are held in Replayed.
+	Resumed bool
+	// Replayed are the changes made since the sequence being resumed from.
+	Replayed []RaceChange
+	// Cancel ends the subscription.
+	Cancel func()
+}
+
+// changeNotifier fans race changes out to in-process subscribers, retaining
+// the most recent changes so subscribers can resume where they left off.
+type changeNotifier struct {
+	mu          sync.Mutex
+	sequence    int64
+	history     []RaceChange
+	nextID      int
+	subscribers map[int]chan RaceChange
+
+	// onFirst and onLast are called as the first subscriber arrives and the
+	// last one leaves, so background work only runs while it is needed.
+	onFirst func()
+	onLast  func()
+
+	// stopStarts stops watching for races starting, guarded by mu.
+	stopStarts chan struct{}
+}
+
+// newChangeNotifier creates a notifier whose sequences start from the current
+// time in microseconds. Sequences therefore keep increasing across restarts,
+// so a sequence issued by a previous process is never mistaken for a
+// retained one.
+func newChangeNotifier(now time.Time) *changeNotifier {
+	return &changeNotifier{
+		sequence:    now.UnixNano() / int64(time.Microsecond),
+		subscribers: make(map[int]chan RaceChange),
+	}
+}
+
+// subscribe registers a new subscriber. When after is positive the changes
+// published since that sequence are replayed, if they are still retained.
+func (n *changeNotifier) subscribe(after int64) *RaceSubscription {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	id := n.nextID
+	n.nextID++
+
+	changes := make(chan RaceChange, subscriberBuffer)
+	n.subscribers[id] = changes
+
+	if len(n.subscribers) == 1 && n.onFirst != nil {
+		n.onFirst()
+	}
+
+	var once sync.Once
+
+	subscription := &RaceSubscription{
+		Changes:  changes,
+		Sequence: n.sequence,
+		Cancel: func() {
+			once.Do(func() {
+				n.mu.Lock()
+				defer n.mu.Unlock()
+
+				n.remove(id)
+			})
+		},
+	}
+
+	if after > 0 && after <= n.sequence && after >= n.oldest()-1 {
+		subscription.Resumed = true
+
+		for _, change := range n.history {
+			if change.Sequence > after {
+				subscription.Replayed = append(subscription.Replayed, change)
+			}
+		}
+	}
+
+	return subscription
+}
+
+// publish assigns the change the next sequence, retains it and delivers it to
+// every subscriber. Subscribers whose buffers are full are dropped rather
+// than blocking the writer.
+func (n *changeNotifier) publish(change RaceChange) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.sequence++
+	change.Sequence = n.sequence
+
+	n.history = append(n.history, change)
+	if len(n.history) > historySize {
+		n.history = n.history[len(n.history)-historySize:]
+	}
+
+	for id, changes := range n.subscribers {
+		select {
+		case changes <- change:
+		default:
+			n.remove(id)
+		}
+	}
+}
+
+// oldest returns the sequence of the oldest retained change, or the next
+// sequence to be published when none are retained. The caller must hold the
+// lock.
+func (n *changeNotifier) oldest() int64 {
+	if len(n.history) == 0 {
+		return n.sequence + 1
+	}
+
+	return n.history[0].Sequence
+}
+
+// remove closes and forgets a subscriber. The caller must hold the lock.
+func (n *changeNotifier) remove(id int) {
+	changes, ok := n.subscribers[id]
+	if !ok {
+		return
+	}
+
+	close(changes)
+	delete(n.subscribers, id)
+
+	if len(n.subscribers) == 0 && n.onLast != nil {
+		n.onLast()
+	}
+}
+
+// watchStarts publishes a STATUS_CHANGED change for every race that starts
+// while there are subscribers, found by periodically asking startedBetween for
+// the races whose advertised start time has passed since the previous check.
+func (n *changeNotifier) watchStarts(clock func() time.Time, startedBetween func(from, to time.Time) ([]*racing.Race, error)) {
+	n.onFirst = func() {
+		n.stopStarts = make(chan struct{})
+
+		go n.pollStarts(n.stopStarts, clock, startedBetween)
+	}
+
+	n.onLast = func() {
+		close(n.stopStarts)
+	}
+}
+
+// pollStarts checks for started races every statusPollInterval until stopped.
+func (n *changeNotifier) pollStarts(stop <-chan struct{}, clock func() time.Time, startedBetween func(from, to time.Time) ([]*racing.Race, error)) {
+	ticker := time.NewTicker(statusPollInterval)
+	defer ticker.Stop()
+
+	last := clock()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		now := clock()
+
+		started, err := startedBetween(last, now)
+		if err != nil {
+			log.Printf("failed checking for started races: %s\n", err)
+			continue
+		}
+
+		last = now
+
+		for _, race := range started {
+			previous := proto.Clone(race).(*racing.Race)
+			previous.Status = racing.Race_OPEN
+
+			n.publish(RaceChange{Type: racing.RaceEvent_STATUS_CHANGED, Race: race, Previous: previous})
+		}
+	}
+}
+
+// PriceChange describes a new price offered on a runner, as published to
+// subscribers of the prices repository.
+type PriceChange struct {
+	// Price is the price offered.
+	Price *racing.Price
+	// Previous is the price it replaced, nil for opening prices.
+	Previous *racing.Price
+}
+
+// PriceSubscription is a subscription to the prices offered on the runners of
+// a race.
+type PriceSubscription struct {
+	// Changes receives prices as they are offered. It is closed when the
+	// subscription is cancelled, or if the subscriber falls too far behind.
+	Changes <-chan PriceChange
+	// Cancel ends the subscription.
+	Cancel func()
+}
+
+// priceNotifier fans price changes out to in-process subscribers, each
+// watching a single race. Unlike race changes, price changes are not retained,
+// as the current prices can always be listed again.
+type priceNotifier struct {
+	mu          sync.Mutex
+	nextID      int
+	subscribers map[int]priceSubscriber
+}
+
+// priceSubscriber is a subscriber to the price changes of a race.
+type priceSubscriber struct {
+	raceID  int64
+	changes chan PriceChange
+}
+
+func newPriceNotifier() *priceNotifier {
+	return &priceNotifier{subscribers: make(map[int]priceSubscriber)}
+}
+
+// subscribe registers a new subscriber to the price changes of a race.
+func (n *priceNotifier) subscribe(raceID int64) *PriceSubscription {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	id := n.nextID
+	n.nextID++
+
+	changes := make(chan PriceChange, subscriberBuffer)
+	n.subscribers[id] = priceSubscriber{raceID: raceID, changes: changes}
+
+	var once sync.Once
+
+	return &PriceSubscription{
+		Changes: changes,
+		Cancel: func() {
+			once.Do(func() {
+				n.mu.Lock()
+				defer n.mu.Unlock()
+
+				n.remove(id)
+			})
+		},
+	}
+}
+
+// publish delivers the change to every subscriber to its race. Subscribers
+// whose buffers are full are dropped rather than blocking the writer.
+func (n *priceNotifier) publish(change PriceChange) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for id, subscriber := range n.subscribers {
+		if subscriber.raceID != change.Price.RaceId {
+			continue
+		}
+
+		select {
+		case subscriber.changes <- change:
+		default:
+			n.remove(id)
+		}
+	}
+}
+
+// remove closes and forgets a subscriber. The caller must hold the lock.
+func (n *priceNotifier) remove(id int) {
+	subscriber, ok := n.subscribers[id]
+	if !ok {
+		return
+	}
+
+	close(subscriber.changes)
+	delete(n.subscribers, id)
+}
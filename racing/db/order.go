@@ -0,0 +1,188 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// defaultRaceOrderBy is used when the caller does not specify an ordering.
+const defaultRaceOrderBy = "advertised_start_time"
+
+// ErrInvalidOrderBy is returned when an order_by value cannot be parsed or
+// references a field races cannot be sorted by.
+var ErrInvalidOrderBy = errors.New("invalid order_by")
+
+// valueKind describes how a sortable column's values are represented in page
+// tokens.
+type valueKind int
+
+const (
+	kindInt valueKind = iota
+	kindString
+	kindBool
+	kindTime
+	kindDate
+)
+
+// orderColumn describes a race column that can be sorted on.
+type orderColumn struct {
+	// column is the name of the column rows are sorted by.
+	column string
+	// kind is the type of the column's values.
+	kind valueKind
+	// value extracts the column's value from a scanned race.
+	value func(race *racing.Race) interface{}
+}
+
+// raceOrderColumns whitelists the race fields that can be sorted on, keyed by
+// their proto field name.
+var raceOrderColumns = map[string]orderColumn{
+	"id": {
+		column: "id", kind: kindInt,
+		value: func(race *racing.Race) interface{} { return race.Id },
+	},
+	"meeting_id": {
+		column: "meeting_id", kind: kindInt,
+		value: func(race *racing.Race) interface{} { return race.MeetingId },
+	},
+	"name": {
+		column: "name", kind: kindString,
+		value: func(race *racing.Race) interface{} { return race.Name },
+	},
+	"number": {
+		column: "number", kind: kindInt,
+		value: func(race *racing.Race) interface{} { return race.Number },
+	},
+	"visible": {
+		column: "visible", kind: kindBool,
+		value: func(race *racing.Race) interface{} { return race.Visible },
+	},
+	"advertised_start_time": {
+		column: "advertised_start_time", kind: kindTime,
+		value: func(race *racing.Race) interface{} {
+			ts, err := ptypes.Timestamp(race.AdvertisedStartTime)
+			if err != nil {
+				return ""
+			}
+
+			return ts.UTC().Format(time.RFC3339)
+		},
+	},
+}
+
+// sql returns the SQL expression rows are sorted by, and the one a bound value
+// is compared through so that cursor values compare the same way rows are
+// sorted. Start times are normalised by the dialect so that timestamps stored
+// with differing UTC offsets still sort chronologically.
+func (c orderColumn) sql(dialect Dialect) (expr, param string) {
+	if c.kind == kindTime {
+		return dialect.Timestamp(c.column), dialect.Timestamp("?")
+	}
+
+	return c.column, "?"
+}
+
+// orderField is a single validated term of an order_by value.
+type orderField struct {
+	field string
+	desc  bool
+}
+
+// parseOrderBy parses an AIP-132 style order_by value, e.g.
+// "advertised_start_time desc, number", into its validated terms.
+//
+// The id is always appended as a final tie-breaker (unless already present) so
+// that rows sharing the same sort values are returned in a stable order, which
+// page tokens rely upon.
+func parseOrderBy(orderBy string) ([]orderField, error) {
+	if strings.TrimSpace(orderBy) == "" {
+		orderBy = defaultRaceOrderBy
+	}
+
+	var (
+		fields []orderField
+		seen   = make(map[string]bool)
+	)
+
+	for _, term := range strings.Split(orderBy, ",") {
+		parts := strings.Fields(term)
+		if len(parts) == 0 || len(parts) > 2 {
+			return nil, fmt.Errorf("%w: malformed term %q", ErrInvalidOrderBy, strings.TrimSpace(term))
+		}
+
+		field := orderField{field: parts[0]}
+		if _, ok := raceOrderColumns[field.field]; !ok {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidOrderBy, field.field)
+		}
+
+		if seen[field.field] {
+			return nil, fmt.Errorf("%w: duplicate field %q", ErrInvalidOrderBy, field.field)
+		}
+		seen[field.field] = true
+
+		if len(parts) == 2 {
+			switch strings.ToLower(parts[1]) {
+			case "asc":
+			case "desc":
+				field.desc = true
+			default:
+				return nil, fmt.Errorf("%w: unknown direction %q for field %q", ErrInvalidOrderBy, parts[1], field.field)
+			}
+		}
+
+		fields = append(fields, field)
+	}
+
+	if !seen["id"] {
+		fields = append(fields, orderField{field: "id"})
+	}
+
+	return fields, nil
+}
+
+// ValidateOrderBy checks an order_by value can be parsed and only references
+// fields races can be sorted by.
+func ValidateOrderBy(orderBy string) error {
+	_, err := parseOrderBy(orderBy)
+
+	return err
+}
+
+// orderKey renders the fields in a canonical form, used to tie page tokens to
+// the ordering they were issued for.
+func orderKey(fields []orderField) string {
+	terms := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		term := field.field
+		if field.desc {
+			term += " desc"
+		}
+
+		terms = append(terms, term)
+	}
+
+	return strings.Join(terms, ",")
+}
+
+// applyOrder appends an ORDER BY clause built from the given fields to the query.
+func applyOrder(query string, fields []orderField, dialect Dialect) string {
+	terms := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		term, _ := raceOrderColumns[field.field].sql(dialect)
+		if field.desc {
+			term += " DESC"
+		}
+
+		terms = append(terms, term)
+	}
+
+	return query + " ORDER BY " + strings.Join(terms, ", ")
+}
@@ -0,0 +1,222 @@
+package db
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+const (
+	// defaultPageSize is used when the caller does not specify a page size.
+	defaultPageSize = 50
+	// maxPageSize caps the number of races returned in a single page.
+	maxPageSize = 100
+)
+
+// ErrInvalidPageToken is returned when a page token cannot be decoded or was
+// issued for a different filter or ordering.
+var ErrInvalidPageToken = errors.New("invalid page_token")
+
+// pageSize returns the effective page size for a requested size.
+func pageSize(requested int32) int {
+	switch {
+	case requested <= 0:
+		return defaultPageSize
+	case requested > maxPageSize:
+		return maxPageSize
+	default:
+		return int(requested)
+	}
+}
+
+// pageCursor is a decoded page token: the sort values of the last race of the
+// previous page, in the order of fields. The id is always the final field, so
+// the cursor identifies a unique position that remains stable as races are
+// inserted, unlike an OFFSET.
+type pageCursor struct {
+	fields []orderField
+	values []interface{}
+}
+
+// pageToken is the wire form of a pageCursor.
+type pageToken struct {
+	// Order is the canonical order_by the token was issued for.
+	Order string `json:"o"`
+	// Filter is a fingerprint of the filter the token was issued for.
+	Filter string `json:"f"`
+	// Values are the sort values of the last race on the previous page.
+	Values []interface{} `json:"v"`
+}
+
+// encodePageToken returns an opaque token positioned after the given race.
+func encodePageToken(fields []orderField, filter *racing.ListRacesRequestFilter, last *racing.Race) (string, error) {
+	values := make([]interface{}, 0, len(fields))
+
+	for _, field := range fields {
+		values = append(values, raceOrderColumns[field.field].value(last))
+	}
+
+	return encodeToken(orderKey(fields), filter, values)
+}
+
+// decodePageToken decodes a token previously returned by encodePageToken,
+// checking it was issued for the same ordering and filter.
+func decodePageToken(encoded string, fields []orderField, filter *racing.ListRacesRequestFilter) (*pageCursor, error) {
+	kinds := make([]valueKind, 0, len(fields))
+
+	for _, field := range fields {
+		kinds = append(kinds, raceOrderColumns[field.field].kind)
+	}
+
+	values, err := decodeToken(encoded, orderKey(fields), filter, kinds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pageCursor{fields: fields, values: values}, nil
+}
+
+// encodeToken returns an opaque token holding the sort values of the last
+// result of a page, tied to the ordering and filter it was issued for.
+func encodeToken(order string, filter proto.Message, values []interface{}) (string, error) {
+	raw, err := json.Marshal(pageToken{
+		Order:  order,
+		Filter: filterKey(filter),
+		Values: values,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// decodeToken decodes a token previously returned by encodeToken, checking it
+// was issued for the same ordering and filter, and returns its values
+// converted to the given kinds.
+func decodeToken(encoded string, order string, filter proto.Message, kinds []valueKind) ([]interface{}, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidPageToken)
+	}
+
+	var token pageToken
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&token); err != nil {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidPageToken)
+	}
+
+	if token.Order != order || token.Filter != filterKey(filter) {
+		return nil, fmt.Errorf("%w: token does not match the request's filter or order_by", ErrInvalidPageToken)
+	}
+
+	if len(token.Values) != len(kinds) {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidPageToken)
+	}
+
+	values := make([]interface{}, len(kinds))
+
+	for i, kind := range kinds {
+		value, err := decodeCursorValue(kind, token.Values[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidPageToken, err)
+		}
+
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+// decodeCursorValue converts a JSON decoded cursor value back into the type
+// bound when comparing against its column.
+func decodeCursorValue(kind valueKind, value interface{}) (interface{}, error) {
+	switch kind {
+	case kindInt:
+		if n, ok := value.(json.Number); ok {
+			return n.Int64()
+		}
+	case kindString:
+		if s, ok := value.(string); ok {
+			return s, nil
+		}
+	case kindBool:
+		if b, ok := value.(bool); ok {
+			return b, nil
+		}
+	case kindTime:
+		if s, ok := value.(string); ok {
+			if _, err := time.Parse(time.RFC3339, s); err != nil {
+				return nil, err
+			}
+
+			return s, nil
+		}
+	case kindDate:
+		if s, ok := value.(string); ok {
+			if _, err := time.Parse(dateLayout, s); err != nil {
+				return nil, err
+			}
+
+			return s, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected value %v", value)
+}
+
+// filterKey fingerprints a filter so page tokens cannot be replayed against a
+// different filter.
+func filterKey(filter proto.Message) string {
+	raw, _ := proto.MarshalOptions{Deterministic: true}.Marshal(filter)
+
+	hash := fnv.New64a()
+	_, _ = hash.Write(raw)
+
+	return fmt.Sprintf("%x", hash.Sum64())
+}
+
+// clause returns a WHERE clause selecting the rows sorted after the cursor,
+// i.e. the rows whose sort values compare greater (or less, for descending
+// fields) than the cursor's lexicographically.
+func (c *pageCursor) clause(dialect Dialect) (string, []interface{}) {
+	var (
+		alternatives []string
+		args         []interface{}
+	)
+
+	for i, field := range c.fields {
+		var terms []string
+
+		for j := 0; j < i; j++ {
+			expr, param := raceOrderColumns[c.fields[j].field].sql(dialect)
+			terms = append(terms, expr+" = "+param)
+			args = append(args, c.values[j])
+		}
+
+		expr, param := raceOrderColumns[field.field].sql(dialect)
+		operator := " > "
+		if field.desc {
+			operator = " < "
+		}
+
+		terms = append(terms, expr+operator+param)
+		args = append(args, c.values[i])
+
+		alternatives = append(alternatives, "("+strings.Join(terms, " AND ")+")")
+	}
+
+	return "(" + strings.Join(alternatives, " OR ") + ")", args
+}
@@ -0,0 +1,334 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"syreclabs.com/go/faker"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// PricesRepo provides repository access to the prices offered on runners.
+type PricesRepo interface {
+	// Init will initialise our prices repository.
+	Init(ctx context.Context) error
+
+	// Seed will insert dummy prices for test/example purposes on the runners
+	// seeded with the same options.
+	Seed(ctx context.Context, opts SeedOptions) error
+
+	// List will return every price offered on the runners of a race, ordered
+	// by runner number, then type, then oldest first.
+	List(ctx context.Context, raceID int64) ([]*racing.Price, error)
+
+	// Create will record a new price, returning it as recorded, or
+	// ErrRunnerNotFound. Prices are not checked against the runner or its
+	// race.
+	Create(ctx context.Context, price *racing.Price) (*racing.Price, error)
+
+	// Subscribe will subscribe to the prices offered on the runners of a race
+	// from now on. The subscription must be cancelled once no longer needed.
+	Subscribe(raceID int64) *PriceSubscription
+}
+
+type pricesRepo struct {
+	store
+	init sync.Once
+
+	// mu serialises the recording of prices, so that the price each change
+	// replaces is the one current when it was recorded.
+	mu       sync.Mutex
+	notifier *priceNotifier
+}
+
+// NewPricesRepo creates a new prices repository.
+func NewPricesRepo(db *sql.DB, opts ...RepoOption) PricesRepo {
+	return &pricesRepo{store: newStore(db, opts), notifier: newPriceNotifier()}
+}
+
+// Init migrates the database schema up to date.
+func (r *pricesRepo) Init(ctx context.Context) error {
+	var err error
+
+	r.init.Do(func() {
+		err = r.migrate(ctx)
+	})
+
+	return err
+}
+
+func (r *pricesRepo) Seed(ctx context.Context, opts SeedOptions) error {
+	prices, err := seedPrices(opts)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	statement, err := tx.PrepareContext(ctx, r.dialect.Rebind(r.dialect.InsertIgnore("prices", []string{
+		"id", "runner_id", "type", "odds", "recorded_at",
+	})))
+	if err != nil {
+		return err
+	}
+	defer statement.Close()
+
+	for i, price := range prices {
+		recordedAt, err := ptypes.Timestamp(price.RecordedAt)
+		if err != nil {
+			return err
+		}
+
+		if _, err := statement.ExecContext(
+			ctx,
+			i+1,
+			price.RunnerId,
+			price.Type.String(),
+			price.Odds,
+			recordedAt.UTC().Format(time.RFC3339),
+		); err != nil {
+			return err
+		}
+	}
+
+	if sync := r.dialect.SyncSequence("prices"); sync != "" {
+		if _, err := tx.ExecContext(ctx, sync); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *pricesRepo) List(ctx context.Context, raceID int64) ([]*racing.Price, error) {
+	ctx, cancel := r.queryContext(ctx)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, r.dialect.Rebind(getPriceQueries()[pricesList]), raceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanPrices(rows)
+}
+
+func (r *pricesRepo) Create(ctx context.Context, price *racing.Price) (*racing.Price, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	runner, err := r.runner(ctx, price.RunnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := r.current(ctx, price.RunnerId, price.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	now := r.clock().Truncate(time.Second)
+
+	if _, err := r.exec(ctx, getPriceQueries()[pricesCreate],
+		price.RunnerId,
+		price.Type.String(),
+		price.Odds,
+		now.UTC().Format(time.RFC3339),
+	); err != nil {
+		return nil, err
+	}
+
+	recorded := &racing.Price{
+		RunnerId:   price.RunnerId,
+		RaceId:     runner.RaceId,
+		Type:       price.Type,
+		Odds:       price.Odds,
+		RecordedAt: timestamppb.New(now),
+	}
+
+	r.notifier.publish(PriceChange{Price: proto.Clone(recorded).(*racing.Price), Previous: previous})
+
+	return recorded, nil
+}
+
+func (r *pricesRepo) Subscribe(raceID int64) *PriceSubscription {
+	return r.notifier.subscribe(raceID)
+}
+
+// runner returns the runner a price is offered on, or ErrRunnerNotFound.
+func (r *pricesRepo) runner(ctx context.Context, id int64) (*racing.Runner, error) {
+	ctx, cancel := r.queryContext(ctx)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, r.dialect.Rebind(getRunnerQueries()[runnersGet]), id)
+	if err != nil {
+		return nil, err
+	}
+
+	runners, err := scanRunners(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(runners) == 0 {
+		return nil, ErrRunnerNotFound
+	}
+
+	return runners[0], nil
+}
+
+// current returns the latest price of a type offered on a runner, or nil if
+// none has been.
+func (r *pricesRepo) current(ctx context.Context, runnerID int64, priceType racing.Price_Type) (*racing.Price, error) {
+	ctx, cancel := r.queryContext(ctx)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, r.dialect.Rebind(getPriceQueries()[pricesCurrent]), runnerID, priceType.String())
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := scanPrices(rows)
+	if err != nil || len(prices) == 0 {
+		return nil, err
+	}
+
+	return prices[0], nil
+}
+
+func scanPrices(rows *sql.Rows) ([]*racing.Price, error) {
+	defer rows.Close()
+
+	var prices []*racing.Price
+
+	for rows.Next() {
+		var (
+			price      racing.Price
+			priceType  string
+			recordedAt time.Time
+		)
+
+		if err := rows.Scan(&price.RunnerId, &price.RaceId, &priceType, &price.Odds, &recordedAt); err != nil {
+			return nil, err
+		}
+
+		price.Type = racing.Price_Type(racing.Price_Type_value[priceType])
+
+		ts, err := ptypes.TimestampProto(recordedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		price.RecordedAt = ts
+
+		prices = append(prices, &price)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return prices, nil
+}
+
+// sortPrices orders prices by runner number, as given by numbers, then type,
+// keeping the prices of each runner and type oldest first.
+func sortPrices(prices []*racing.Price, numbers map[int64]int64) {
+	sort.SliceStable(prices, func(i, j int) bool {
+		if a, b := numbers[prices[i].RunnerId], numbers[prices[j].RunnerId]; a != b {
+			return a < b
+		}
+
+		return prices[i].Type < prices[j].Type
+	})
+}
+
+// seedPrices generates the dummy prices described by the options, on every
+// runner seeded with them that is not scratched. So that no price is offered
+// after the races it could have been taken on have started, fixed prices open
+// twelve hours before the start of the time window and fluctuate every two
+// hours, and tote prices open two hours before and fluctuate every half hour.
+// IDs run from 1 upwards, in the order returned.
+func seedPrices(opts SeedOptions) ([]*racing.Price, error) {
+	runners, err := seedRunners(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prices draw from their own sequence, so runners are seeded the same
+	// whether or not prices are.
+	faker.Seed(opts.Seed)
+
+	// Each race's market is framed from chances weighted at random, with a
+	// 20% margin.
+	chances := make(map[int64][]int)
+	totals := make(map[int64]int)
+
+	for _, runner := range runners {
+		chance := 0
+		if !runner.Scratched {
+			chance = faker.RandomInt(1, 20)
+		}
+
+		chances[runner.RaceId] = append(chances[runner.RaceId], chance)
+		totals[runner.RaceId] += chance
+	}
+
+	var prices []*racing.Price
+
+	for _, runner := range runners {
+		chance := chances[runner.RaceId][int(runner.Number-1)]
+		if chance == 0 {
+			continue
+		}
+
+		fixed := seedOdds(float64(totals[runner.RaceId]) / (1.2 * float64(chance)))
+		tote := seedOdds(fixed * float64(faker.RandomInt(90, 110)) / 100)
+
+		for _, market := range []struct {
+			priceType    racing.Price_Type
+			odds         float64
+			opens, every time.Duration
+			fluctuations int
+		}{
+			{racing.Price_FIXED, fixed, 12 * time.Hour, 2 * time.Hour, faker.RandomInt(0, 4)},
+			{racing.Price_TOTE, tote, 2 * time.Hour, 30 * time.Minute, faker.RandomInt(0, 3)},
+		} {
+			odds := market.odds
+			at := opts.From.Truncate(time.Second).Add(-market.opens)
+
+			for f := 0; f <= market.fluctuations; f++ {
+				if f > 0 {
+					odds = seedOdds(odds * float64(faker.RandomInt(80, 125)) / 100)
+					at = at.Add(market.every)
+				}
+
+				prices = append(prices, &racing.Price{
+					RunnerId:   runner.Id,
+					RaceId:     runner.RaceId,
+					Type:       market.priceType,
+					Odds:       odds,
+					RecordedAt: timestamppb.New(at),
+				})
+			}
+		}
+	}
+
+	return prices, nil
+}
+
+// seedOdds rounds dummy odds to the cent, no shorter than 1.01.
+func seedOdds(odds float64) float64 {
+	return math.Max(1.01, math.Round(odds*100)/100)
+}
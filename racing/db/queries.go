@@ -1,7 +1,11 @@
 package db
 
 const (
-	racesList = "list"
+	racesList   = "list"
+	racesGet    = "get"
+	racesCreate = "create"
+	racesDelete = "delete"
+	racesExists = "exists"
 )
 
 func getRaceQueries() map[string]string {
@@ -13,8 +17,224 @@ func getRaceQueries() map[string]string {
 				name, 
 				number, 
 				visible, 
-				advertised_start_time 
+				advertised_start_time, 
+				status 
 			FROM races
 		`,
+		racesGet: `
+			SELECT 
+				id, 
+				meeting_id, 
+				name, 
+				number, 
+				visible, 
+				advertised_start_time, 
+				status 
+			FROM races
+			WHERE id = ?
+		`,
+		racesCreate: `
+			INSERT INTO races(meeting_id, name, number, visible, advertised_start_time) 
+			VALUES (?,?,?,?,?)
+		`,
+		racesDelete: `
+			DELETE FROM races 
+			WHERE id = ?
+		`,
+		racesExists: `
+			SELECT id 
+			FROM races
+			WHERE id = ?
+		`,
+	}
+}
+
+const (
+	meetingsList   = "list"
+	meetingsGet    = "get"
+	meetingsCreate = "create"
+	meetingsExists = "exists"
+)
+
+func getMeetingQueries() map[string]string {
+	return map[string]string{
+		meetingsList: `
+			SELECT 
+				id, 
+				name, 
+				venue, 
+				country, 
+				race_type, 
+				date, 
+				track_condition, 
+				weather 
+			FROM meetings
+		`,
+		meetingsGet: `
+			SELECT 
+				id, 
+				name, 
+				venue, 
+				country, 
+				race_type, 
+				date, 
+				track_condition, 
+				weather 
+			FROM meetings
+			WHERE id = ?
+		`,
+		meetingsCreate: `
+			INSERT INTO meetings(name, venue, country, race_type, date, track_condition, weather) 
+			VALUES (?,?,?,?,?,?,?)
+		`,
+		meetingsExists: `
+			SELECT id 
+			FROM meetings
+			WHERE id = ?
+		`,
+	}
+}
+
+const (
+	runnersList   = "list"
+	runnersGet    = "get"
+	runnersCreate = "create"
+	runnersExists = "exists"
+)
+
+func getRunnerQueries() map[string]string {
+	return map[string]string{
+		runnersList: `
+			SELECT 
+				id, 
+				race_id, 
+				number, 
+				barrier, 
+				name, 
+				jockey, 
+				trainer, 
+				weight, 
+				scratched, 
+				form 
+			FROM runners
+		`,
+		runnersGet: `
+			SELECT 
+				id, 
+				race_id, 
+				number, 
+				barrier, 
+				name, 
+				jockey, 
+				trainer, 
+				weight, 
+				scratched, 
+				form 
+			FROM runners
+			WHERE id = ?
+		`,
+		runnersCreate: `
+			INSERT INTO runners(race_id, number, barrier, name, jockey, trainer, weight, scratched, form) 
+			VALUES (?,?,?,?,?,?,?,?,?)
+		`,
+		runnersExists: `
+			SELECT id 
+			FROM runners
+			WHERE race_id = ? AND number = ?
+		`,
+	}
+}
+
+const (
+	resultsGet     = "get"
+	resultsCreate  = "create"
+	placingsCreate = "placing"
+)
+
+func getResultQueries() map[string]string {
+	return map[string]string{
+		resultsGet: `
+			SELECT 
+				results.resulted_at, 
+				placings.runner_id, 
+				placings.position 
+			FROM results
+			JOIN placings ON placings.race_id = results.race_id
+			WHERE results.race_id = ?
+			ORDER BY placings.position, placings.runner_id
+		`,
+		resultsCreate: `
+			INSERT INTO results(race_id, resulted_at) 
+			VALUES (?,?)
+		`,
+		placingsCreate: `
+			INSERT INTO placings(race_id, runner_id, position) 
+			VALUES (?,?,?)
+		`,
+	}
+}
+
+const (
+	statusHistoryList   = "list"
+	statusHistoryCreate = "create"
+)
+
+func getStatusHistoryQueries() map[string]string {
+	return map[string]string{
+		statusHistoryList: `
+			SELECT 
+				race_id, 
+				from_status, 
+				to_status, 
+				actor, 
+				changed_at 
+			FROM race_status_history
+			WHERE race_id = ?
+			ORDER BY id
+		`,
+		statusHistoryCreate: `
+			INSERT INTO race_status_history(race_id, from_status, to_status, actor, changed_at) 
+			VALUES (?,?,?,?,?)
+		`,
+	}
+}
+
+const (
+	pricesList    = "list"
+	pricesCurrent = "current"
+	pricesCreate  = "create"
+)
+
+func getPriceQueries() map[string]string {
+	return map[string]string{
+		pricesList: `
+			SELECT 
+				prices.runner_id, 
+				runners.race_id, 
+				prices.type, 
+				prices.odds, 
+				prices.recorded_at 
+			FROM prices
+			JOIN runners ON runners.id = prices.runner_id
+			WHERE runners.race_id = ?
+			ORDER BY runners.number, prices.type, prices.id
+		`,
+		pricesCurrent: `
+			SELECT 
+				prices.runner_id, 
+				runners.race_id, 
+				prices.type, 
+				prices.odds, 
+				prices.recorded_at 
+			FROM prices
+			JOIN runners ON runners.id = prices.runner_id
+			WHERE prices.runner_id = ? AND prices.type = ?
+			ORDER BY prices.id DESC
+			LIMIT 1
+		`,
+		pricesCreate: `
+			INSERT INTO prices(runner_id, type, odds, recorded_at) 
+			VALUES (?,?,?,?)
+		`,
 	}
 }
@@ -1,9 +1,11 @@
 package db
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"github.com/golang/protobuf/ptypes"
-	_ "github.com/mattn/go-sqlite3"
 	"strings"
 	"sync"
 	"time"
@@ -14,61 +16,442 @@ import (
 // RacesRepo provides repository access to races.
 type RacesRepo interface {
 	// Init will initialise our races repository.
-	Init() error
+	Init(ctx context.Context) error
 
-	// List will return a list of races.
-	List(filter *racing.ListRacesRequestFilter) ([]*racing.Race, error)
+	// Seed will insert dummy races for test/example purposes.
+	Seed(ctx context.Context, opts SeedOptions) error
+
+	// List will return a page of races matching the request's filter, sorted
+	// by its order_by, along with the token of the next page, if any.
+	List(ctx context.Context, in *racing.ListRacesRequest) ([]*racing.Race, string, error)
+
+	// Get will return a single race by its ID, or ErrRaceNotFound.
+	Get(ctx context.Context, id int64) (*racing.Race, error)
+
+	// Create will insert a new race, returning it with its assigned ID.
+	Create(ctx context.Context, race *racing.Race) (*racing.Race, error)
+
+	// Update will update the given fields of an existing race, returning the
+	// updated race, or ErrRaceNotFound. The status is only updated when named
+	// by the fields, returning ErrInvalidTransition unless the race can move
+	// to it, and recording the transition as made by the actor.
+	Update(ctx context.Context, race *racing.Race, fields []string, actor string) (*racing.Race, error)
+
+	// Delete will delete a race by its ID, or return ErrRaceNotFound.
+	Delete(ctx context.Context, id int64) error
+
+	// Result will record the placings of a race and mark it RESULTED,
+	// returning the result, or ErrRaceNotFound or ErrInvalidTransition. The
+	// transition is recorded as made by the actor. Placings are not checked
+	// against the race's runners.
+	Result(ctx context.Context, result *racing.RaceResult, actor string) (*racing.RaceResult, error)
+
+	// GetResult will return the result of a race, or ErrResultNotFound.
+	GetResult(ctx context.Context, raceID int64) (*racing.RaceResult, error)
+
+	// ListStatusHistory will return the status transitions of a race, oldest
+	// first. Races closing as their start time passes are not transitions.
+	ListStatusHistory(ctx context.Context, raceID int64) ([]*racing.RaceStatusChange, error)
+
+	// Subscribe will subscribe to changes made to races. When after is
+	// positive, the changes made since that sequence are replayed if they are
+	// still retained.
+	Subscribe(after int64) *RaceSubscription
+}
+
+var (
+	// ErrRaceNotFound is returned when a requested race does not exist.
+	ErrRaceNotFound = errors.New("race not found")
+
+	// ErrInvalidUpdateMask is returned when an update names a field that
+	// cannot be updated.
+	ErrInvalidUpdateMask = errors.New("invalid update_mask")
+)
+
+// raceUpdateColumns whitelists the race fields that can be written, keyed by
+// their proto field name, with a function extracting the column's value.
+var raceUpdateColumns = map[string]func(race *racing.Race) (interface{}, error){
+	"meeting_id": func(race *racing.Race) (interface{}, error) { return race.MeetingId, nil },
+	"name":       func(race *racing.Race) (interface{}, error) { return race.Name, nil },
+	"number":     func(race *racing.Race) (interface{}, error) { return race.Number, nil },
+	"visible":    func(race *racing.Race) (interface{}, error) { return race.Visible, nil },
+	"advertised_start_time": func(race *racing.Race) (interface{}, error) {
+		ts, err := ptypes.Timestamp(race.AdvertisedStartTime)
+		if err != nil {
+			return nil, err
+		}
+
+		return ts.UTC().Format(time.RFC3339), nil
+	},
+}
+
+// RaceUpdateFields returns the names of all race fields that can be updated.
+func RaceUpdateFields() []string {
+	return []string{"meeting_id", "name", "number", "visible", "advertised_start_time"}
 }
 
 type racesRepo struct {
-	db   *sql.DB
-	init sync.Once
+	store
+	init     sync.Once
+	notifier *changeNotifier
 }
 
 // NewRacesRepo creates a new races repository.
-func NewRacesRepo(db *sql.DB) RacesRepo {
-	return &racesRepo{db: db}
+func NewRacesRepo(db *sql.DB, opts ...RepoOption) RacesRepo {
+	r := &racesRepo{store: newStore(db, opts)}
+
+	r.notifier = newChangeNotifier(r.clock())
+	r.notifier.watchStarts(r.clock, r.startedBetween)
+
+	return r
 }
 
-// Init prepares the race repository dummy data.
-func (r *racesRepo) Init() error {
+// Init migrates the database schema up to date.
+func (r *racesRepo) Init(ctx context.Context) error {
 	var err error
 
 	r.init.Do(func() {
-		// For test/example purposes, we seed the DB with some dummy races.
-		err = r.seed()
+		err = r.migrate(ctx)
 	})
 
 	return err
 }
 
-func (r *racesRepo) List(filter *racing.ListRacesRequestFilter) ([]*racing.Race, error) {
+func (r *racesRepo) List(ctx context.Context, in *racing.ListRacesRequest) ([]*racing.Race, string, error) {
 	var (
 		err   error
 		query string
 		args  []interface{}
+		after *pageCursor
 	)
 
+	order, err := parseOrderBy(in.OrderBy)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if in.PageToken != "" {
+		after, err = decodePageToken(in.PageToken, order, in.Filter)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	size := pageSize(in.PageSize)
+
+	// Statuses are derived relative to a single point in time so that filtering
+	// and scanning agree with one another.
+	now := r.clock()
+
 	query = getRaceQueries()[racesList]
 
-	query, args = r.applyFilter(query, filter)
+	query, args = r.applyFilter(query, in.Filter, now, after)
+	query = applyOrder(query, order, r.dialect)
+
+	// One more race than the page holds is fetched to learn whether there is a
+	// following page.
+	query += " LIMIT ?"
+	args = append(args, size+1)
+
+	ctx, cancel := r.queryContext(ctx)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, r.dialect.Rebind(query), args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	races, err := r.scanRaces(rows, now)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(races) <= size {
+		return races, "", nil
+	}
+
+	races = races[:size]
+
+	next, err := encodePageToken(order, in.Filter, races[size-1])
+	if err != nil {
+		return nil, "", err
+	}
+
+	return races, next, nil
+}
+
+func (r *racesRepo) Get(ctx context.Context, id int64) (*racing.Race, error) {
+	now := r.clock()
+
+	ctx, cancel := r.queryContext(ctx)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, r.dialect.Rebind(getRaceQueries()[racesGet]), id)
+	if err != nil {
+		return nil, err
+	}
+
+	races, err := r.scanRaces(rows, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(races) == 0 {
+		return nil, ErrRaceNotFound
+	}
+
+	return races[0], nil
+}
+
+func (r *racesRepo) Create(ctx context.Context, race *racing.Race) (*racing.Race, error) {
+	var args []interface{}
+
+	if err := r.checkMeeting(ctx, race.MeetingId); err != nil {
+		return nil, err
+	}
+
+	for _, field := range RaceUpdateFields() {
+		value, err := raceUpdateColumns[field](race)
+		if err != nil {
+			return nil, err
+		}
+
+		args = append(args, value)
+	}
+
+	id, err := r.insert(ctx, getRaceQueries()[racesCreate], args...)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := r.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.notifier.publish(RaceChange{Type: racing.RaceEvent_CREATED, Race: created})
+
+	return created, nil
+}
+
+func (r *racesRepo) Update(ctx context.Context, race *racing.Race, fields []string, actor string) (*racing.Race, error) {
+	var (
+		sets          []string
+		args          []interface{}
+		updatesStatus bool
+	)
+
+	for _, field := range fields {
+		if field == raceStatusField {
+			updatesStatus = true
+			continue
+		}
+
+		column, ok := raceUpdateColumns[field]
+		if !ok {
+			return nil, fmt.Errorf("%w: field %q cannot be updated", ErrInvalidUpdateMask, field)
+		}
+
+		value, err := column(race)
+		if err != nil {
+			return nil, err
+		}
+
+		sets = append(sets, field+" = ?")
+		args = append(args, value)
+	}
+
+	if len(sets) == 0 && !updatesStatus {
+		return r.Get(ctx, race.Id)
+	}
+
+	for _, field := range fields {
+		if field == "meeting_id" {
+			if err := r.checkMeeting(ctx, race.MeetingId); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	previous, updated, err := r.updateRace(ctx, race, fields, sets, args, updatesStatus, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	r.notifier.publish(RaceChange{Type: racing.RaceEvent_UPDATED, Race: updated, Previous: previous})
+
+	return updated, nil
+}
+
+// updateRace applies the column assignments of an update to a race and, when
+// it transitions the race to another status, records the transition, in a
+// single transaction. The race is read before and after the update in the
+// same transaction, so that they are the rows the update replaced and left.
+func (r *racesRepo) updateRace(
+	ctx context.Context,
+	race *racing.Race,
+	fields, sets []string,
+	args []interface{},
+	updatesStatus bool,
+	actor string,
+) (previous, updated *racing.Race, err error) {
+	ctx, cancel := r.queryContext(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	now := r.clock()
+
+	if previous, err = r.getForUpdate(ctx, tx, race.Id, now); err != nil {
+		return nil, nil, err
+	}
+
+	// Updating a race to the status it already has leaves the status alone.
+	transitions := updatesStatus && race.Status != previous.Status
+
+	if transitions {
+		if err := checkStatusUpdate(previous, race, fields, now); err != nil {
+			return nil, nil, err
+		}
+
+		sets = append(sets, "status = ?")
+		args = append(args, storedStatus(race.Status))
+	}
+
+	if len(sets) > 0 {
+		query := "UPDATE races SET " + strings.Join(sets, ", ") + " WHERE id = ?"
+		args = append(args, previous.Id)
+
+		// A transition only applies to the status it was checked against, so
+		// that concurrent transitions cannot both apply.
+		if transitions {
+			query += " AND COALESCE(status, '') = ?"
+			args = append(args, storedStatusText(previous.Status))
+		}
+
+		result, err := tx.ExecContext(ctx, r.dialect.Rebind(query), args...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if affected, err := result.RowsAffected(); err != nil {
+			return nil, nil, err
+		} else if affected == 0 {
+			if transitions {
+				return nil, nil, fmt.Errorf("%w: race %d changed status concurrently", ErrInvalidTransition, previous.Id)
+			}
+
+			return nil, nil, ErrRaceNotFound
+		}
+
+		if transitions {
+			if err := r.recordTransition(ctx, tx, previous.Id, previous.Status, race.Status, actor, now); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if updated, err = r.getForUpdate(ctx, tx, race.Id, now); err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return previous, updated, nil
+}
+
+// Delete deletes a race, reading it in the same transaction so that the
+// change published is the row deleted.
+func (r *racesRepo) Delete(ctx context.Context, id int64) error {
+	ctx, cancel := r.queryContext(ctx)
+	defer cancel()
 
-	rows, err := r.db.Query(query, args...)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	previous, err := r.getForUpdate(ctx, tx, id, r.clock())
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, r.dialect.Rebind(getRaceQueries()[racesDelete]), id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrRaceNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	r.notifier.publish(RaceChange{Type: racing.RaceEvent_DELETED, Race: previous, Previous: previous})
+
+	return nil
+}
+
+// getForUpdate gets a race within a transaction that goes on to change it,
+// locking its row until the transaction ends where the dialect needs to.
+func (r *racesRepo) getForUpdate(ctx context.Context, tx *sql.Tx, id int64, now time.Time) (*racing.Race, error) {
+	rows, err := tx.QueryContext(ctx, r.dialect.Rebind(getRaceQueries()[racesGet]+r.dialect.ForUpdate()), id)
 	if err != nil {
 		return nil, err
 	}
 
-	return r.scanRaces(rows)
+	races, err := r.scanRaces(rows, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(races) == 0 {
+		return nil, ErrRaceNotFound
+	}
+
+	return races[0], nil
+}
+
+// checkMeeting returns ErrMeetingNotFound unless the meeting exists.
+func (r *racesRepo) checkMeeting(ctx context.Context, id int64) error {
+	found, err := r.exists(ctx, getMeetingQueries()[meetingsExists], id)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return ErrMeetingNotFound
+	}
+
+	return nil
 }
 
-func (r *racesRepo) applyFilter(query string, filter *racing.ListRacesRequestFilter) (string, []interface{}) {
+func (r *racesRepo) Subscribe(after int64) *RaceSubscription {
+	return r.notifier.subscribe(after)
+}
+
+func (r *racesRepo) applyFilter(query string, filter *racing.ListRacesRequestFilter, now time.Time, after *pageCursor) (string, []interface{}) {
 	var (
 		clauses []string
 		args    []interface{}
 	)
 
 	if filter == nil {
-		return query, args
+		filter = &racing.ListRacesRequestFilter{}
 	}
 
 	if len(filter.MeetingIds) > 0 {
@@ -79,6 +462,59 @@ func (r *racesRepo) applyFilter(query string, filter *racing.ListRacesRequestFil
 		}
 	}
 
+	if len(filter.RaceTypes) > 0 || len(filter.Venues) > 0 {
+		var meetingClauses []string
+
+		if len(filter.RaceTypes) > 0 {
+			meetingClauses = append(meetingClauses, "race_type IN ("+strings.Repeat("?,", len(filter.RaceTypes)-1)+"?)")
+
+			for _, raceType := range filter.RaceTypes {
+				args = append(args, raceType.String())
+			}
+		}
+
+		if len(filter.Venues) > 0 {
+			meetingClauses = append(meetingClauses, "venue IN ("+strings.Repeat("?,", len(filter.Venues)-1)+"?)")
+
+			for _, venue := range filter.Venues {
+				args = append(args, venue)
+			}
+		}
+
+		clauses = append(clauses, "meeting_id IN (SELECT id FROM meetings WHERE "+strings.Join(meetingClauses, " AND ")+")")
+	}
+
+	switch filter.Visibility {
+	case racing.Visibility_VISIBILITY_VISIBLE:
+		clauses = append(clauses, "visible = ?")
+		args = append(args, true)
+	case racing.Visibility_VISIBILITY_HIDDEN:
+		clauses = append(clauses, "visible = ?")
+		args = append(args, false)
+	}
+
+	start, param := r.dialect.Timestamp("advertised_start_time"), r.dialect.Timestamp("?")
+
+	// OPEN and CLOSED are derived for races without a stored status.
+	switch filter.Status {
+	case racing.Race_STATUS_UNSPECIFIED:
+	case racing.Race_OPEN:
+		clauses = append(clauses, "status IS NULL AND "+start+" > "+param)
+		args = append(args, now.UTC().Format(time.RFC3339))
+	case racing.Race_CLOSED:
+		clauses = append(clauses, "status IS NULL AND "+start+" <= "+param)
+		args = append(args, now.UTC().Format(time.RFC3339))
+	default:
+		clauses = append(clauses, "status = ?")
+		args = append(args, filter.Status.String())
+	}
+
+	if after != nil {
+		clause, cursorArgs := after.clause(r.dialect)
+		clauses = append(clauses, clause)
+		args = append(args, cursorArgs...)
+	}
+
 	if len(clauses) != 0 {
 		query += " WHERE " + strings.Join(clauses, " AND ")
 	}
@@ -88,14 +524,18 @@ func (r *racesRepo) applyFilter(query string, filter *racing.ListRacesRequestFil
 
 func (m *racesRepo) scanRaces(
 	rows *sql.Rows,
+	now time.Time,
 ) ([]*racing.Race, error) {
+	defer rows.Close()
+
 	var races []*racing.Race
 
 	for rows.Next() {
 		var race racing.Race
 		var advertisedStart time.Time
+		var status sql.NullString
 
-		if err := rows.Scan(&race.Id, &race.MeetingId, &race.Name, &race.Number, &race.Visible, &advertisedStart); err != nil {
+		if err := rows.Scan(&race.Id, &race.MeetingId, &race.Name, &race.Number, &race.Visible, &advertisedStart, &status); err != nil {
 			if err == sql.ErrNoRows {
 				return nil, nil
 			}
@@ -109,9 +549,102 @@ func (m *racesRepo) scanRaces(
 		}
 
 		race.AdvertisedStartTime = ts
+		race.Status = raceStatus(advertisedStart, now, racing.Race_Status(racing.Race_Status_value[status.String]))
 
 		races = append(races, &race)
 	}
 
+	// A query cancelled part way through ends iteration early; report it rather
+	// than returning a partial result.
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
 	return races, nil
 }
+
+// raceStatus returns the stored status of a race, or when none is stored
+// derives it from the race's advertised start time.
+func raceStatus(advertisedStart, now time.Time, stored racing.Race_Status) racing.Race_Status {
+	if stored != racing.Race_STATUS_UNSPECIFIED {
+		return stored
+	}
+
+	if advertisedStart.After(now) {
+		return racing.Race_OPEN
+	}
+
+	return racing.Race_CLOSED
+}
+
+// startedBetween returns the races whose advertised start time is after from
+// but not after to, and whose status is derived from it.
+func (r *racesRepo) startedBetween(from, to time.Time) ([]*racing.Race, error) {
+	ctx, cancel := r.queryContext(context.Background())
+	defer cancel()
+
+	start, param := r.dialect.Timestamp("advertised_start_time"), r.dialect.Timestamp("?")
+
+	query := getRaceQueries()[racesList] +
+		" WHERE status IS NULL AND " + start + " > " + param + " AND " + start + " <= " + param +
+		" ORDER BY " + start + ", id"
+
+	rows, err := r.db.QueryContext(ctx, r.dialect.Rebind(query), from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.scanRaces(rows, to)
+}
+
+// RaceMatchesFilter reports whether a race, held at the given meeting,
+// satisfies a filter, mirroring the clauses applied when listing races. The
+// meeting is only consulted when the filter restricts race types or venues,
+// and may otherwise be nil.
+func RaceMatchesFilter(race *racing.Race, meeting *racing.Meeting, filter *racing.ListRacesRequestFilter) bool {
+	if filter == nil {
+		return true
+	}
+
+	if FilterNeedsMeeting(filter) && (meeting == nil || !meetingMatches(meeting, filter.RaceTypes, filter.Venues)) {
+		return false
+	}
+
+	if len(filter.MeetingIds) > 0 {
+		found := false
+
+		for _, meetingID := range filter.MeetingIds {
+			if race.MeetingId == meetingID {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	switch filter.Visibility {
+	case racing.Visibility_VISIBILITY_VISIBLE:
+		if !race.Visible {
+			return false
+		}
+	case racing.Visibility_VISIBILITY_HIDDEN:
+		if race.Visible {
+			return false
+		}
+	}
+
+	if filter.Status != racing.Race_STATUS_UNSPECIFIED && race.Status != filter.Status {
+		return false
+	}
+
+	return true
+}
+
+// FilterNeedsMeeting reports whether matching races against a filter needs
+// their meetings, as it restricts race types or venues.
+func FilterNeedsMeeting(filter *racing.ListRacesRequestFilter) bool {
+	return filter != nil && (len(filter.RaceTypes) > 0 || len(filter.Venues) > 0)
+}
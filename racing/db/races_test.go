@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// testClock is a clock tests set by hand. It is read by the notifier's
+// goroutine as well as the test, so is guarded.
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *testClock) set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = now
+}
+
+func TestRacesRepoStatusFollowsClock(t *testing.T) {
+	ctx := context.Background()
+	start := conformanceNow.Add(time.Hour)
+
+	for _, test := range []struct {
+		name    string
+		newRepo func(t *testing.T, clock func() time.Time) RacesRepo
+	}{
+		{"sqlite", func(t *testing.T, clock func() time.Time) RacesRepo {
+			db, dialect, err := Open(filepath.Join(t.TempDir(), "racing.db"))
+			if err != nil {
+				t.Fatalf("opening database: %s", err)
+			}
+			t.Cleanup(func() { _ = db.Close() })
+
+			opts := []RepoOption{WithDialect(dialect), WithClock(clock)}
+			meetings := NewMeetingsRepo(db, opts...)
+			races := NewRacesRepo(db, opts...)
+
+			for _, repo := range []interface{ Init(context.Context) error }{races, meetings} {
+				if err := repo.Init(ctx); err != nil {
+					t.Fatalf("initialising repository: %s", err)
+				}
+			}
+
+			createConformanceMeetings(t, meetings)
+
+			return races
+		}},
+		{"memory", func(t *testing.T, clock func() time.Time) RacesRepo {
+			meetings := NewMemoryMeetingsRepo()
+			createConformanceMeetings(t, meetings)
+
+			return NewMemoryRacesRepo(meetings, WithClock(clock))
+		}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			clock := &testClock{now: start.Add(-time.Second)}
+			repo := test.newRepo(t, clock.Now)
+
+			created, err := repo.Create(ctx, &racing.Race{MeetingId: 1, Name: "Alpha", Number: 1, AdvertisedStartTime: timestamppb.New(start)})
+			if err != nil {
+				t.Fatalf("creating race: %s", err)
+			}
+
+			// A race is OPEN until its advertised start time, and CLOSED from
+			// it on.
+			for _, step := range []struct {
+				now  time.Time
+				want racing.Race_Status
+			}{
+				{start.Add(-time.Second), racing.Race_OPEN},
+				{start, racing.Race_CLOSED},
+				{start.Add(time.Second), racing.Race_CLOSED},
+			} {
+				clock.set(step.now)
+
+				got, err := repo.Get(ctx, created.Id)
+				if err != nil {
+					t.Fatalf("getting race: %s", err)
+				}
+
+				if got.Status != step.want {
+					t.Errorf("at %s: got status %s, want %s", step.now, got.Status, step.want)
+				}
+
+				for _, status := range []racing.Race_Status{racing.Race_OPEN, racing.Race_CLOSED} {
+					var want []int64
+					if status == step.want {
+						want = []int64{created.Id}
+					}
+
+					got := listAll(t, repo, &racing.ListRacesRequest{Filter: &racing.ListRacesRequestFilter{Status: status}})
+					if !reflect.DeepEqual(got, want) {
+						t.Errorf("at %s: listing %s races got %v, want %v", step.now, status, got, want)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/protobuf/proto"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// ErrResultNotFound is returned when a race has no result.
+var ErrResultNotFound = errors.New("result not found")
+
+func (r *racesRepo) Result(ctx context.Context, result *racing.RaceResult, actor string) (*racing.RaceResult, error) {
+	previous, resulted, err := r.recordResult(ctx, result, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	r.notifier.publish(RaceChange{Type: racing.RaceEvent_STATUS_CHANGED, Race: resulted, Previous: previous})
+
+	return r.GetResult(ctx, result.RaceId)
+}
+
+// recordResult marks a race RESULTED, records the transition and inserts its
+// placings, in a single transaction. The race is read before and after in the
+// same transaction, so that they are the rows marking it replaced and left.
+func (r *racesRepo) recordResult(ctx context.Context, result *racing.RaceResult, actor string) (previous, resulted *racing.Race, err error) {
+	ctx, cancel := r.queryContext(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	now := r.clock()
+
+	if previous, err = r.getForUpdate(ctx, tx, result.RaceId, now); err != nil {
+		return nil, nil, err
+	}
+
+	if err := checkTransition(result.RaceId, previous.Status, racing.Race_RESULTED); err != nil {
+		return nil, nil, err
+	}
+
+	// The race is only marked if it still has the status it was checked
+	// against, so that a race resulted concurrently is not resulted twice.
+	query := "UPDATE races SET status = ? WHERE id = ? AND COALESCE(status, '') = ?"
+	args := []interface{}{racing.Race_RESULTED.String(), result.RaceId, storedStatusText(previous.Status)}
+
+	if previous.Status == racing.Race_CLOSED {
+		query += " AND " + r.dialect.Timestamp("advertised_start_time") + " <= " + r.dialect.Timestamp("?")
+		args = append(args, now.UTC().Format(time.RFC3339))
+	}
+
+	marked, err := tx.ExecContext(ctx, r.dialect.Rebind(query), args...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if affected, err := marked.RowsAffected(); err != nil {
+		return nil, nil, err
+	} else if affected == 0 {
+		return nil, nil, fmt.Errorf("%w: race %d is no longer %s", ErrInvalidTransition, result.RaceId, previous.Status)
+	}
+
+	if err := r.recordTransition(ctx, tx, result.RaceId, previous.Status, racing.Race_RESULTED, actor, now); err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, r.dialect.Rebind(getResultQueries()[resultsCreate]), result.RaceId, now.UTC().Format(time.RFC3339)); err != nil {
+		return nil, nil, err
+	}
+
+	for _, placing := range result.Placings {
+		if _, err := tx.ExecContext(ctx, r.dialect.Rebind(getResultQueries()[placingsCreate]), result.RaceId, placing.RunnerId, placing.Position); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if resulted, err = r.getForUpdate(ctx, tx, result.RaceId, now); err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return previous, resulted, nil
+}
+
+func (r *racesRepo) GetResult(ctx context.Context, raceID int64) (*racing.RaceResult, error) {
+	ctx, cancel := r.queryContext(ctx)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, r.dialect.Rebind(getResultQueries()[resultsGet]), raceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := &racing.RaceResult{RaceId: raceID}
+
+	for rows.Next() {
+		var (
+			placing    racing.Placing
+			resultedAt time.Time
+		)
+
+		if err := rows.Scan(&resultedAt, &placing.RunnerId, &placing.Position); err != nil {
+			return nil, err
+		}
+
+		if result.ResultedAt, err = ptypes.TimestampProto(resultedAt); err != nil {
+			return nil, err
+		}
+
+		result.Placings = append(result.Placings, &placing)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(result.Placings) == 0 {
+		return nil, ErrResultNotFound
+	}
+
+	result.Placings = sortPlacings(result.Placings)
+
+	return result, nil
+}
+
+// sortPlacings returns copies of placings ordered by position, then runner
+// ID, marking those that share a position as dead heats.
+func sortPlacings(placings []*racing.Placing) []*racing.Placing {
+	sorted := make([]*racing.Placing, 0, len(placings))
+	shared := make(map[int64]int)
+
+	for _, placing := range placings {
+		sorted = append(sorted, proto.Clone(placing).(*racing.Placing))
+		shared[placing.Position]++
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Position != sorted[j].Position {
+			return sorted[i].Position < sorted[j].Position
+		}
+
+		return sorted[i].RunnerId < sorted[j].RunnerId
+	})
+
+	for _, placing := range sorted {
+		placing.DeadHeat = shared[placing.Position] > 1
+	}
+
+	return sorted
+}
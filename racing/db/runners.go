@@ -0,0 +1,318 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"syreclabs.com/go/faker"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+var (
+	// ErrRunnerNotFound is returned when a runner does not exist.
+	ErrRunnerNotFound = errors.New("runner not found")
+
+	// ErrDuplicateRunner is returned when a runner is created with the number
+	// of another runner in the same race.
+	ErrDuplicateRunner = errors.New("runner number already taken")
+)
+
+// RunnersRepo provides repository access to the runners of races.
+type RunnersRepo interface {
+	// Init will initialise our runners repository.
+	Init(ctx context.Context) error
+
+	// Seed will insert dummy runners for test/example purposes into the races
+	// seeded with the same options.
+	Seed(ctx context.Context, opts SeedOptions) error
+
+	// List will return the runners of the given races in a single query,
+	// keyed by race ID and ordered by number. Races without runners, or that
+	// do not exist, are absent from the map.
+	List(ctx context.Context, raceIDs []int64) (map[int64][]*racing.Runner, error)
+
+	// Get will return a single runner by its ID, or ErrRunnerNotFound.
+	Get(ctx context.Context, id int64) (*racing.Runner, error)
+
+	// Create will insert a new runner, returning it with its assigned ID, or
+	// return ErrRaceNotFound or ErrDuplicateRunner.
+	Create(ctx context.Context, runner *racing.Runner) (*racing.Runner, error)
+}
+
+type runnersRepo struct {
+	store
+	init sync.Once
+}
+
+// NewRunnersRepo creates a new runners repository.
+func NewRunnersRepo(db *sql.DB, opts ...RepoOption) RunnersRepo {
+	return &runnersRepo{store: newStore(db, opts)}
+}
+
+// Init migrates the database schema up to date.
+func (r *runnersRepo) Init(ctx context.Context) error {
+	var err error
+
+	r.init.Do(func() {
+		err = r.migrate(ctx)
+	})
+
+	return err
+}
+
+func (r *runnersRepo) Seed(ctx context.Context, opts SeedOptions) error {
+	runners, err := seedRunners(opts)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	statement, err := tx.PrepareContext(ctx, r.dialect.Rebind(r.dialect.InsertIgnore("runners", []string{
+		"id", "race_id", "number", "barrier", "name", "jockey", "trainer", "weight", "scratched", "form",
+	})))
+	if err != nil {
+		return err
+	}
+	defer statement.Close()
+
+	for _, runner := range runners {
+		if _, err := statement.ExecContext(
+			ctx,
+			runner.Id,
+			runner.RaceId,
+			runner.Number,
+			runner.Barrier,
+			runner.Name,
+			runner.Jockey,
+			runner.Trainer,
+			runner.Weight,
+			runner.Scratched,
+			runner.Form,
+		); err != nil {
+			return err
+		}
+	}
+
+	if sync := r.dialect.SyncSequence("runners"); sync != "" {
+		if _, err := tx.ExecContext(ctx, sync); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *runnersRepo) List(ctx context.Context, raceIDs []int64) (map[int64][]*racing.Runner, error) {
+	runners := make(map[int64][]*racing.Runner)
+
+	if len(raceIDs) == 0 {
+		return runners, nil
+	}
+
+	query := getRunnerQueries()[runnersList] +
+		" WHERE race_id IN (" + strings.Repeat("?,", len(raceIDs)-1) + "?) ORDER BY race_id, number"
+
+	args := make([]interface{}, 0, len(raceIDs))
+	for _, id := range raceIDs {
+		args = append(args, id)
+	}
+
+	ctx, cancel := r.queryContext(ctx)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, r.dialect.Rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+
+	scanned, err := scanRunners(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, runner := range scanned {
+		runners[runner.RaceId] = append(runners[runner.RaceId], runner)
+	}
+
+	return runners, nil
+}
+
+func (r *runnersRepo) Get(ctx context.Context, id int64) (*racing.Runner, error) {
+	ctx, cancel := r.queryContext(ctx)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, r.dialect.Rebind(getRunnerQueries()[runnersGet]), id)
+	if err != nil {
+		return nil, err
+	}
+
+	runners, err := scanRunners(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(runners) == 0 {
+		return nil, ErrRunnerNotFound
+	}
+
+	return runners[0], nil
+}
+
+func (r *runnersRepo) Create(ctx context.Context, runner *racing.Runner) (*racing.Runner, error) {
+	found, err := r.exists(ctx, getRaceQueries()[racesExists], runner.RaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, ErrRaceNotFound
+	}
+
+	taken, err := r.exists(ctx, getRunnerQueries()[runnersExists], runner.RaceId, runner.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	if taken {
+		return nil, ErrDuplicateRunner
+	}
+
+	id, err := r.insert(ctx, getRunnerQueries()[runnersCreate],
+		runner.RaceId,
+		runner.Number,
+		runner.Barrier,
+		runner.Name,
+		runner.Jockey,
+		runner.Trainer,
+		runner.Weight,
+		runner.Scratched,
+		runner.Form,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	created := proto.Clone(runner).(*racing.Runner)
+	created.Id = id
+
+	return created, nil
+}
+
+func scanRunners(rows *sql.Rows) ([]*racing.Runner, error) {
+	defer rows.Close()
+
+	var runners []*racing.Runner
+
+	for rows.Next() {
+		var runner racing.Runner
+
+		if err := rows.Scan(
+			&runner.Id,
+			&runner.RaceId,
+			&runner.Number,
+			&runner.Barrier,
+			&runner.Name,
+			&runner.Jockey,
+			&runner.Trainer,
+			&runner.Weight,
+			&runner.Scratched,
+			&runner.Form,
+		); err != nil {
+			return nil, err
+		}
+
+		runners = append(runners, &runner)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return runners, nil
+}
+
+// seedRunners generates the dummy runners described by the options, filling
+// each seeded race with opts.Runners runners. IDs run from 1 upwards, race by
+// race.
+func seedRunners(opts SeedOptions) ([]*racing.Runner, error) {
+	meetings, err := seedMeetings(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	races, err := seedRaces(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	raceTypes := make(map[int64]racing.Meeting_RaceType, len(meetings))
+	for _, meeting := range meetings {
+		raceTypes[meeting.Id] = meeting.RaceType
+	}
+
+	// Runners draw from their own sequence, so meetings and races are seeded
+	// the same whether or not runners are.
+	faker.Seed(opts.Seed)
+
+	runners := make([]*racing.Runner, 0, len(races)*opts.Runners)
+
+	for _, race := range races {
+		raceType := raceTypes[race.MeetingId]
+
+		// Barriers are a shuffle of the runner numbers.
+		barriers := make([]int64, opts.Runners)
+		for i := range barriers {
+			j := faker.RandomInt(0, i)
+			barriers[i], barriers[j] = barriers[j], int64(i+1)
+		}
+
+		for i := 0; i < opts.Runners; i++ {
+			runner := &racing.Runner{
+				Id:        int64(len(runners) + 1),
+				RaceId:    race.Id,
+				Number:    int64(i + 1),
+				Barrier:   barriers[i],
+				Name:      faker.App().Name() + " " + faker.App().Name(),
+				Trainer:   faker.Name().Name(),
+				Scratched: faker.RandomInt(1, 10) == 1,
+				Form:      seedForm(),
+			}
+
+			switch raceType {
+			case racing.Meeting_GREYHOUND:
+				runner.Weight = float64(faker.RandomInt(260, 360)) / 10
+			case racing.Meeting_HARNESS:
+				runner.Jockey = faker.Name().Name()
+			default:
+				runner.Jockey = faker.Name().Name()
+				runner.Weight = float64(faker.RandomInt(108, 122)) / 2
+			}
+
+			runners = append(runners, runner)
+		}
+	}
+
+	return runners, nil
+}
+
+// seedForm generates the recent finishing positions of a dummy runner, where
+// 0 is a finish outside the first nine and x a spell.
+func seedForm() string {
+	var form strings.Builder
+
+	for i := faker.RandomInt(0, 5); i > 0; i-- {
+		form.WriteString(faker.RandomChoice([]string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "0", "x"}))
+	}
+
+	return form.String()
+}
@@ -0,0 +1,158 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// ErrInvalidTransition is returned when a race cannot move from its status to
+// the one requested.
+var ErrInvalidTransition = errors.New("invalid status transition")
+
+// raceTransitions lists the statuses each status can move to. OPEN and CLOSED
+// are derived from the advertised start time, so no race moves between them
+// explicitly; RESULTED and ABANDONED are final.
+var raceTransitions = map[racing.Race_Status][]racing.Race_Status{
+	racing.Race_OPEN:      {racing.Race_POSTPONED, racing.Race_ABANDONED},
+	racing.Race_CLOSED:    {racing.Race_INTERIM, racing.Race_RESULTED, racing.Race_ABANDONED},
+	racing.Race_POSTPONED: {racing.Race_OPEN, racing.Race_ABANDONED},
+	racing.Race_INTERIM:   {racing.Race_PROTESTED, racing.Race_RESULTED},
+	racing.Race_PROTESTED: {racing.Race_INTERIM, racing.Race_RESULTED},
+}
+
+// CanTransition reports whether a race can move from one status to another.
+func CanTransition(from, to racing.Race_Status) bool {
+	for _, allowed := range raceTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkTransition returns ErrInvalidTransition unless a race can move from
+// one status to another.
+func checkTransition(id int64, from, to racing.Race_Status) error {
+	if !CanTransition(from, to) {
+		return fmt.Errorf("%w: race %d cannot move from %s to %s", ErrInvalidTransition, id, from, to)
+	}
+
+	return nil
+}
+
+// raceStatusField is the race field naming its status in update masks. It is
+// not one of RaceUpdateFields, so is only updated when named.
+const raceStatusField = "status"
+
+// storedStatus returns the value of the status column for a status. Derived
+// statuses are not stored.
+func storedStatus(status racing.Race_Status) interface{} {
+	if status == racing.Race_OPEN || status == racing.Race_CLOSED {
+		return nil
+	}
+
+	return status.String()
+}
+
+// storedStatusText returns the value of the status column for a status, with
+// derived statuses as "".
+func storedStatusText(status racing.Race_Status) string {
+	if text, ok := storedStatus(status).(string); ok {
+		return text
+	}
+
+	return ""
+}
+
+// checkStatusUpdate returns ErrInvalidTransition unless an update can move a
+// race from its previous status to the status of the update. Reopened races
+// derive their status from their start time again, so it must not have
+// passed.
+func checkStatusUpdate(previous, race *racing.Race, fields []string, now time.Time) error {
+	if err := checkTransition(previous.Id, previous.Status, race.Status); err != nil {
+		return err
+	}
+
+	if race.Status != racing.Race_OPEN {
+		return nil
+	}
+
+	start := previous.AdvertisedStartTime
+	for _, field := range fields {
+		if field == "advertised_start_time" {
+			start = race.AdvertisedStartTime
+		}
+	}
+
+	advertisedStart, err := ptypes.Timestamp(start)
+	if err != nil {
+		return err
+	}
+
+	if !advertisedStart.After(now) {
+		return fmt.Errorf("%w: race %d cannot reopen with a start time that has passed", ErrInvalidTransition, previous.Id)
+	}
+
+	return nil
+}
+
+// recordTransition inserts a status transition into the history of a race.
+func (r *racesRepo) recordTransition(ctx context.Context, tx *sql.Tx, id int64, from, to racing.Race_Status, actor string, at time.Time) error {
+	_, err := tx.ExecContext(ctx, r.dialect.Rebind(getStatusHistoryQueries()[statusHistoryCreate]),
+		id,
+		from.String(),
+		to.String(),
+		actor,
+		at.UTC().Format(time.RFC3339),
+	)
+
+	return err
+}
+
+func (r *racesRepo) ListStatusHistory(ctx context.Context, raceID int64) ([]*racing.RaceStatusChange, error) {
+	ctx, cancel := r.queryContext(ctx)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, r.dialect.Rebind(getStatusHistoryQueries()[statusHistoryList]), raceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []*racing.RaceStatusChange
+
+	for rows.Next() {
+		var (
+			change    racing.RaceStatusChange
+			from, to  string
+			changedAt time.Time
+		)
+
+		if err := rows.Scan(&change.RaceId, &from, &to, &change.Actor, &changedAt); err != nil {
+			return nil, err
+		}
+
+		change.From = racing.Race_Status(racing.Race_Status_value[from])
+		change.To = racing.Race_Status(racing.Race_Status_value[to])
+
+		if change.ChangedAt, err = ptypes.TimestampProto(changedAt); err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, &change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
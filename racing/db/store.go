@@ -0,0 +1,111 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// defaultQueryTimeout bounds each query unless overridden by WithQueryTimeout.
+const defaultQueryTimeout = 5 * time.Second
+
+// store holds the database, and settings, shared by the SQL repositories.
+type store struct {
+	db           *sql.DB
+	dialect      Dialect
+	clock        func() time.Time
+	queryTimeout time.Duration
+}
+
+// RepoOption configures optional behaviour of a repository.
+type RepoOption func(*store)
+
+// WithClock overrides the clock used to derive race statuses, allowing tests
+// to freeze time.
+func WithClock(clock func() time.Time) RepoOption {
+	return func(s *store) {
+		s.clock = clock
+	}
+}
+
+// WithDialect sets the SQL dialect of the database, which defaults to SQLite.
+func WithDialect(dialect Dialect) RepoOption {
+	return func(s *store) {
+		s.dialect = dialect
+	}
+}
+
+// WithQueryTimeout bounds the time each query may take, in addition to any
+// deadline of the caller's context. A zero timeout leaves queries bounded by
+// the caller's context alone.
+func WithQueryTimeout(timeout time.Duration) RepoOption {
+	return func(s *store) {
+		s.queryTimeout = timeout
+	}
+}
+
+// newStore creates a store for the database, configured by the options.
+func newStore(db *sql.DB, opts []RepoOption) store {
+	s := store{db: db, dialect: SQLite, clock: time.Now, queryTimeout: defaultQueryTimeout}
+
+	for _, opt := range opts {
+		opt(&s)
+	}
+
+	return s
+}
+
+// migrate migrates the database schema up to date.
+func (s *store) migrate(ctx context.Context) error {
+	migrator, err := NewMigrator(s.db, s.dialect)
+	if err != nil {
+		return err
+	}
+
+	_, err = migrator.Up(ctx)
+
+	return err
+}
+
+// queryContext bounds ctx by the repository's per-query timeout, so that
+// queries stop once either the caller gives up or the timeout passes.
+func (s *store) queryContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.queryTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, s.queryTimeout)
+}
+
+// exec executes a statement bounded by the per-query timeout.
+func (s *store) exec(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	return s.db.ExecContext(ctx, s.dialect.Rebind(query), args...)
+}
+
+// insert executes an insert statement bounded by the per-query timeout,
+// returning the ID of the row inserted.
+func (s *store) insert(ctx context.Context, query string, args ...interface{}) (int64, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	return s.dialect.InsertID(ctx, s.db, s.dialect.Rebind(query), args...)
+}
+
+// exists reports whether a query returns any rows.
+func (s *store) exists(ctx context.Context, query string, args ...interface{}) (bool, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, s.dialect.Rebind(query), args...)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	found := rows.Next()
+
+	return found, rows.Err()
+}
@@ -1,10 +1,14 @@
 package main
 
 import (
-	"database/sql"
+	"context"
+	"errors"
 	"flag"
+	"fmt"
 	"log"
 	"net"
+	"os"
+	"time"
 
 	"git.neds.sh/matty/entain/racing/db"
 	"git.neds.sh/matty/entain/racing/proto/racing"
@@ -12,13 +16,45 @@ import (
 	"google.golang.org/grpc"
 )
 
+// memoryDSN selects an in-memory races repository, which needs no database
+// but forgets every race when the service stops.
+const memoryDSN = "memory"
+
 var (
 	grpcEndpoint = flag.String("grpc-endpoint", "localhost:9000", "gRPC server endpoint")
+	dsn          = flag.String("dsn", "./db/racing.db", "Racing database: a postgres:// URL, the path of a SQLite database, or \"memory\" for an empty in-memory store")
+	queryTimeout = flag.Duration("query-timeout", 5*time.Second, "Maximum duration of a single database query, 0 for no limit")
 )
 
 func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [migrate up|down|status | seed [seed flags]]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+
 	flag.Parse()
 
+	if flag.Arg(0) == "migrate" {
+		if err := migrate(context.Background(), flag.Arg(1)); err != nil {
+			log.Fatalf("failed migrating database: %s\n", err)
+		}
+
+		return
+	}
+
+	if flag.Arg(0) == "seed" {
+		if err := seed(context.Background(), flag.Args()[1:]); err != nil {
+			log.Fatalf("failed seeding database: %s\n", err)
+		}
+
+		return
+	}
+
+	if flag.NArg() > 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
 	if err := run(); err != nil {
 		log.Fatalf("failed running grpc server: %s\n", err)
 	}
@@ -30,14 +66,24 @@ func run() error {
 		return err
 	}
 
-	racingDB, err := sql.Open("sqlite3", "./db/racing.db")
+	racesRepo, meetingsRepo, runnersRepo, pricesRepo, err := openRepos()
 	if err != nil {
 		return err
 	}
-	
 
-	racesRepo := db.NewRacesRepo(racingDB)
-	if err := racesRepo.Init(); err != nil {
+	if err := racesRepo.Init(context.Background()); err != nil {
+		return err
+	}
+
+	if err := meetingsRepo.Init(context.Background()); err != nil {
+		return err
+	}
+
+	if err := runnersRepo.Init(context.Background()); err != nil {
+		return err
+	}
+
+	if err := pricesRepo.Init(context.Background()); err != nil {
 		return err
 	}
 
@@ -47,6 +93,17 @@ func run() error {
 		grpcServer,
 		service.NewRacingService(
 			racesRepo,
+			meetingsRepo,
+			runnersRepo,
+		),
+	)
+
+	racing.RegisterPricesServer(
+		grpcServer,
+		service.NewPricesService(
+			racesRepo,
+			runnersRepo,
+			pricesRepo,
 		),
 	)
 
@@ -58,3 +115,157 @@ func run() error {
 
 	return nil
 }
+
+// openRepos opens the races, meetings, runners and prices repositories named
+// by the dsn flag.
+func openRepos() (db.RacesRepo, db.MeetingsRepo, db.RunnersRepo, db.PricesRepo, error) {
+	if *dsn == memoryDSN {
+		meetingsRepo := db.NewMemoryMeetingsRepo()
+		racesRepo := db.NewMemoryRacesRepo(meetingsRepo)
+		runnersRepo := db.NewMemoryRunnersRepo(racesRepo)
+
+		return racesRepo, meetingsRepo, runnersRepo, db.NewMemoryPricesRepo(runnersRepo), nil
+	}
+
+	racingDB, dialect, err := db.Open(*dsn)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	opts := []db.RepoOption{db.WithDialect(dialect), db.WithQueryTimeout(*queryTimeout)}
+
+	return db.NewRacesRepo(racingDB, opts...), db.NewMeetingsRepo(racingDB, opts...), db.NewRunnersRepo(racingDB, opts...), db.NewPricesRepo(racingDB, opts...), nil
+}
+
+// migrate runs a migrate subcommand: "up" applies pending migrations, "down"
+// reverts the latest applied migration and "status" lists every migration.
+func migrate(ctx context.Context, command string) error {
+	if *dsn == memoryDSN {
+		return errors.New("the in-memory store cannot be migrated or seeded, as it only lasts as long as the service")
+	}
+
+	racingDB, dialect, err := db.Open(*dsn)
+	if err != nil {
+		return err
+	}
+	defer racingDB.Close()
+
+	migrator, err := db.NewMigrator(racingDB, dialect)
+	if err != nil {
+		return err
+	}
+
+	switch command {
+	case "up":
+		migrated, err := migrator.Up(ctx)
+		for _, migration := range migrated {
+			log.Printf("applied migration %04d_%s\n", migration.Version, migration.Name)
+		}
+
+		if err == nil && len(migrated) == 0 {
+			log.Println("no pending migrations")
+		}
+
+		return err
+	case "down":
+		migration, err := migrator.Down(ctx)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("reverted migration %04d_%s\n", migration.Version, migration.Name)
+
+		return nil
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, status := range statuses {
+			applied := "pending"
+			if status.Applied {
+				applied = "applied " + status.AppliedAt.Format(time.RFC3339)
+			}
+
+			fmt.Printf("%04d_%s\t%s\n", status.Version, status.Name, applied)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command %q, expected up, down or status", command)
+	}
+}
+
+// seed runs the seed subcommand, migrating the database and inserting dummy
+// meetings, races, runners and prices. The same flags always produce the same
+// data, so the output can be used as a reproducible fixture.
+func seed(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("seed", flag.ExitOnError)
+	value := flags.Int64("value", 1, "Value seeding the random generator")
+	meetings := flags.Int("meetings", 10, "Number of meetings to insert")
+	races := flags.Int("races", 100, "Number of races to insert")
+	runners := flags.Int("runners", 8, "Number of runners to insert into each race")
+	anchor := flags.String("anchor", "", "RFC3339 time the time window of meeting dates and race start times is relative to, defaults to now")
+	from := flags.Duration("from", -24*time.Hour, "Start of the time window, relative to the anchor")
+	to := flags.Duration("to", 48*time.Hour, "End of the time window, relative to the anchor")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	at := time.Now()
+	if *anchor != "" {
+		var err error
+
+		if at, err = time.Parse(time.RFC3339, *anchor); err != nil {
+			return fmt.Errorf("invalid anchor: %w", err)
+		}
+	}
+
+	if *dsn == memoryDSN {
+		return errors.New("the in-memory store cannot be migrated or seeded, as it only lasts as long as the service")
+	}
+
+	racingDB, dialect, err := db.Open(*dsn)
+	if err != nil {
+		return err
+	}
+	defer racingDB.Close()
+
+	meetingsRepo := db.NewMeetingsRepo(racingDB, db.WithDialect(dialect))
+	if err := meetingsRepo.Init(ctx); err != nil {
+		return err
+	}
+
+	opts := db.SeedOptions{
+		Seed:     *value,
+		Meetings: *meetings,
+		Races:    *races,
+		Runners:  *runners,
+		From:     at.Add(*from),
+		To:       at.Add(*to),
+	}
+
+	// Races reference meetings, runners races and prices runners, so each must
+	// be seeded before the next.
+	if err := meetingsRepo.Seed(ctx, opts); err != nil {
+		return err
+	}
+
+	if err := db.NewRacesRepo(racingDB, db.WithDialect(dialect)).Seed(ctx, opts); err != nil {
+		return err
+	}
+
+	if err := db.NewRunnersRepo(racingDB, db.WithDialect(dialect)).Seed(ctx, opts); err != nil {
+		return err
+	}
+
+	if err := db.NewPricesRepo(racingDB, db.WithDialect(dialect)).Seed(ctx, opts); err != nil {
+		return err
+	}
+
+	log.Printf("seeded %d meetings and %d races of %d priced runners\n", *meetings, *races, *runners)
+
+	return nil
+}
@@ -1,3 +1,4 @@
 package proto
 
 //go:generate protoc --go_out=. --go-grpc_out=require_unimplemented_servers=false:. racing/racing.proto
+//go:generate protoc --go_out=. --go-grpc_out=require_unimplemented_servers=false:. racing/prices.proto
@@ -0,0 +1,211 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package racing
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PricesClient is the client API for Prices service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PricesClient interface {
+	// GetRacePrices will return the opening and current prices of the runners
+	// of a race.
+	GetRacePrices(ctx context.Context, in *GetRacePricesRequest, opts ...grpc.CallOption) (*GetRacePricesResponse, error)
+	// SetPrice will record a new price for a runner of an OPEN race, returning
+	// it.
+	SetPrice(ctx context.Context, in *SetPriceRequest, opts ...grpc.CallOption) (*Price, error)
+	// WatchPrices will stream a snapshot of the current prices of a race,
+	// followed by their fluctuations as they happen.
+	WatchPrices(ctx context.Context, in *WatchPricesRequest, opts ...grpc.CallOption) (Prices_WatchPricesClient, error)
+}
+
+type pricesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPricesClient(cc grpc.ClientConnInterface) PricesClient {
+	return &pricesClient{cc}
+}
+
+func (c *pricesClient) GetRacePrices(ctx context.Context, in *GetRacePricesRequest, opts ...grpc.CallOption) (*GetRacePricesResponse, error) {
+	out := new(GetRacePricesResponse)
+	err := c.cc.Invoke(ctx, "/racing.Prices/GetRacePrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pricesClient) SetPrice(ctx context.Context, in *SetPriceRequest, opts ...grpc.CallOption) (*Price, error) {
+	out := new(Price)
+	err := c.cc.Invoke(ctx, "/racing.Prices/SetPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pricesClient) WatchPrices(ctx context.Context, in *WatchPricesRequest, opts ...grpc.CallOption) (Prices_WatchPricesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Prices_ServiceDesc.Streams[0], "/racing.Prices/WatchPrices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pricesWatchPricesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Prices_WatchPricesClient interface {
+	Recv() (*PriceEvent, error)
+	grpc.ClientStream
+}
+
+type pricesWatchPricesClient struct {
+	grpc.ClientStream
+}
+
+func (x *pricesWatchPricesClient) Recv() (*PriceEvent, error) {
+	m := new(PriceEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// PricesServer is the server API for Prices service.
+// All implementations should embed UnimplementedPricesServer
+// for forward compatibility
+type PricesServer interface {
+	// GetRacePrices will return the opening and current prices of the runners
+	// of a race.
+	GetRacePrices(context.Context, *GetRacePricesRequest) (*GetRacePricesResponse, error)
+	// SetPrice will record a new price for a runner of an OPEN race, returning
+	// it.
+	SetPrice(context.Context, *SetPriceRequest) (*Price, error)
+	// WatchPrices will stream a snapshot of the current prices of a race,
+	// followed by their fluctuations as they happen.
+	WatchPrices(*WatchPricesRequest, Prices_WatchPricesServer) error
+}
+
+// UnimplementedPricesServer should be embedded to have forward compatible implementations.
+type UnimplementedPricesServer struct {
+}
+
+func (UnimplementedPricesServer) GetRacePrices(context.Context, *GetRacePricesRequest) (*GetRacePricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRacePrices not implemented")
+}
+func (UnimplementedPricesServer) SetPrice(context.Context, *SetPriceRequest) (*Price, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrice not implemented")
+}
+func (UnimplementedPricesServer) WatchPrices(*WatchPricesRequest, Prices_WatchPricesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPrices not implemented")
+}
+
+// UnsafePricesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PricesServer will
+// result in compilation errors.
+type UnsafePricesServer interface {
+	mustEmbedUnimplementedPricesServer()
+}
+
+func RegisterPricesServer(s grpc.ServiceRegistrar, srv PricesServer) {
+	s.RegisterService(&Prices_ServiceDesc, srv)
+}
+
+func _Prices_GetRacePrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRacePricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PricesServer).GetRacePrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racing.Prices/GetRacePrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PricesServer).GetRacePrices(ctx, req.(*GetRacePricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Prices_SetPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PricesServer).SetPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racing.Prices/SetPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PricesServer).SetPrice(ctx, req.(*SetPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Prices_WatchPrices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPricesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PricesServer).WatchPrices(m, &pricesWatchPricesServer{stream})
+}
+
+type Prices_WatchPricesServer interface {
+	Send(*PriceEvent) error
+	grpc.ServerStream
+}
+
+type pricesWatchPricesServer struct {
+	grpc.ServerStream
+}
+
+func (x *pricesWatchPricesServer) Send(m *PriceEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Prices_ServiceDesc is the grpc.ServiceDesc for Prices service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Prices_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "racing.Prices",
+	HandlerType: (*PricesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRacePrices",
+			Handler:    _Prices_GetRacePrices_Handler,
+		},
+		{
+			MethodName: "SetPrice",
+			Handler:    _Prices_SetPrice_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPrices",
+			Handler:       _Prices_WatchPrices_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "racing/prices.proto",
+}
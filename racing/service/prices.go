@@ -0,0 +1,177 @@
+package service
+
+import (
+	"errors"
+
+	"git.neds.sh/matty/entain/racing/db"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Prices interface {
+	// GetRacePrices will return the opening and current prices of a race's
+	// runners.
+	GetRacePrices(ctx context.Context, in *racing.GetRacePricesRequest) (*racing.GetRacePricesResponse, error)
+
+	// SetPrice will record a new price for a runner.
+	SetPrice(ctx context.Context, in *racing.SetPriceRequest) (*racing.Price, error)
+
+	// WatchPrices will stream the current prices of a race followed by their
+	// fluctuations.
+	WatchPrices(in *racing.WatchPricesRequest, stream racing.Prices_WatchPricesServer) error
+}
+
+// pricesService implements the Prices interface.
+type pricesService struct {
+	racesRepo   db.RacesRepo
+	runnersRepo db.RunnersRepo
+	pricesRepo  db.PricesRepo
+}
+
+// NewPricesService instantiates and returns a new pricesService.
+func NewPricesService(racesRepo db.RacesRepo, runnersRepo db.RunnersRepo, pricesRepo db.PricesRepo) Prices {
+	return &pricesService{racesRepo, runnersRepo, pricesRepo}
+}
+
+func (s *pricesService) GetRacePrices(ctx context.Context, in *racing.GetRacePricesRequest) (*racing.GetRacePricesResponse, error) {
+	if err := s.checkRace(ctx, in.RaceId); err != nil {
+		return nil, err
+	}
+
+	prices, err := s.pricesRepo.List(ctx, in.RaceId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &racing.GetRacePricesResponse{Prices: groupPrices(prices, in.IncludeHistory)}, nil
+}
+
+func (s *pricesService) SetPrice(ctx context.Context, in *racing.SetPriceRequest) (*racing.Price, error) {
+	if err := validateSetPriceRequest(in); err != nil {
+		return nil, err
+	}
+
+	runner, err := s.runnersRepo.Get(ctx, in.RunnerId)
+	if err != nil {
+		if errors.Is(err, db.ErrRunnerNotFound) {
+			return nil, status.Errorf(codes.NotFound, "runner %d not found", in.RunnerId)
+		}
+
+		return nil, toStatus(err)
+	}
+
+	if runner.Scratched {
+		return nil, status.Errorf(codes.FailedPrecondition, "runner %d is scratched", in.RunnerId)
+	}
+
+	race, err := s.racesRepo.Get(ctx, runner.RaceId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	if race.Status != racing.Race_OPEN {
+		return nil, status.Errorf(codes.FailedPrecondition, "race %d is %s, only OPEN races can be priced", race.Id, race.Status)
+	}
+
+	price, err := s.pricesRepo.Create(ctx, &racing.Price{RunnerId: in.RunnerId, Type: in.Type, Odds: in.Odds})
+	if err != nil {
+		if errors.Is(err, db.ErrRunnerNotFound) {
+			return nil, status.Errorf(codes.NotFound, "runner %d not found", in.RunnerId)
+		}
+
+		return nil, toStatus(err)
+	}
+
+	return price, nil
+}
+
+func (s *pricesService) WatchPrices(in *racing.WatchPricesRequest, stream racing.Prices_WatchPricesServer) error {
+	if err := s.checkRace(stream.Context(), in.RaceId); err != nil {
+		return err
+	}
+
+	// Subscribe before taking the snapshot so that no price offered while it
+	// is being sent is missed; at worst a price is sent twice.
+	subscription := s.pricesRepo.Subscribe(in.RaceId)
+	defer subscription.Cancel()
+
+	prices, err := s.pricesRepo.List(stream.Context(), in.RaceId)
+	if err != nil {
+		return toStatus(err)
+	}
+
+	for _, runnerPrices := range groupPrices(prices, false) {
+		if err := stream.Send(&racing.PriceEvent{
+			Type:  racing.PriceEvent_SNAPSHOT,
+			Price: runnerPrices.Current,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := stream.Send(&racing.PriceEvent{Type: racing.PriceEvent_SYNCED}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case change, ok := <-subscription.Changes:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "watcher fell too far behind, please reconnect")
+			}
+
+			if err := stream.Send(&racing.PriceEvent{
+				Type:     racing.PriceEvent_FLUCTUATED,
+				Price:    change.Price,
+				Previous: change.Previous,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// checkRace returns a NotFound error unless a race exists.
+func (s *pricesService) checkRace(ctx context.Context, id int64) error {
+	if _, err := s.racesRepo.Get(ctx, id); err != nil {
+		if errors.Is(err, db.ErrRaceNotFound) {
+			return status.Errorf(codes.NotFound, "race %d not found", id)
+		}
+
+		return toStatus(err)
+	}
+
+	return nil
+}
+
+// groupPrices groups prices, ordered by runner and type and oldest first, into
+// the prices of each runner and type, keeping every price when history is
+// set.
+func groupPrices(prices []*racing.Price, history bool) []*racing.RunnerPrices {
+	var grouped []*racing.RunnerPrices
+
+	for _, price := range prices {
+		last := len(grouped) - 1
+
+		if last < 0 || grouped[last].RunnerId != price.RunnerId || grouped[last].Type != price.Type {
+			grouped = append(grouped, &racing.RunnerPrices{
+				RunnerId: price.RunnerId,
+				Type:     price.Type,
+				Opening:  price,
+			})
+			last++
+		}
+
+		grouped[last].Current = price
+
+		if history {
+			grouped[last].History = append(grouped[last].History, price)
+		}
+	}
+
+	return grouped
+}
@@ -1,31 +1,470 @@
 package service
 
 import (
+	"errors"
+	"fmt"
+
 	"git.neds.sh/matty/entain/racing/db"
 	"git.neds.sh/matty/entain/racing/proto/racing"
+	"github.com/golang/protobuf/ptypes/empty"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Racing interface {
 	// ListRaces will return a collection of races.
 	ListRaces(ctx context.Context, in *racing.ListRacesRequest) (*racing.ListRacesResponse, error)
+
+	// GetRace will return a single race.
+	GetRace(ctx context.Context, in *racing.GetRaceRequest) (*racing.Race, error)
+
+	// CreateRace will create a new race.
+	CreateRace(ctx context.Context, in *racing.CreateRaceRequest) (*racing.Race, error)
+
+	// UpdateRace will update the masked fields of a race.
+	UpdateRace(ctx context.Context, in *racing.UpdateRaceRequest) (*racing.Race, error)
+
+	// DeleteRace will delete a race.
+	DeleteRace(ctx context.Context, in *racing.DeleteRaceRequest) (*empty.Empty, error)
+
+	// ListMeetings will return a collection of meetings.
+	ListMeetings(ctx context.Context, in *racing.ListMeetingsRequest) (*racing.ListMeetingsResponse, error)
+
+	// GetMeeting will return a single meeting.
+	GetMeeting(ctx context.Context, in *racing.GetMeetingRequest) (*racing.Meeting, error)
+
+	// ListRunners will return the runners of a race.
+	ListRunners(ctx context.Context, in *racing.ListRunnersRequest) (*racing.ListRunnersResponse, error)
+
+	// ResultRace will record the placings of a closed race.
+	ResultRace(ctx context.Context, in *racing.ResultRaceRequest) (*racing.RaceResult, error)
+
+	// GetRaceResult will return the result of a race.
+	GetRaceResult(ctx context.Context, in *racing.GetRaceResultRequest) (*racing.RaceResult, error)
+
+	// ListRaceStatusHistory will return the status transitions of a race.
+	ListRaceStatusHistory(ctx context.Context, in *racing.ListRaceStatusHistoryRequest) (*racing.ListRaceStatusHistoryResponse, error)
+
+	// WatchRaces will stream a snapshot of races followed by changes to them.
+	WatchRaces(in *racing.WatchRacesRequest, stream racing.Racing_WatchRacesServer) error
 }
 
 // racingService implements the Racing interface.
 type racingService struct {
-	racesRepo db.RacesRepo
+	racesRepo    db.RacesRepo
+	meetingsRepo db.MeetingsRepo
+	runnersRepo  db.RunnersRepo
 }
 
 // NewRacingService instantiates and returns a new racingService.
-func NewRacingService(racesRepo db.RacesRepo) Racing {
-	return &racingService{racesRepo}
+func NewRacingService(racesRepo db.RacesRepo, meetingsRepo db.MeetingsRepo, runnersRepo db.RunnersRepo) Racing {
+	return &racingService{racesRepo, meetingsRepo, runnersRepo}
 }
 
 func (s *racingService) ListRaces(ctx context.Context, in *racing.ListRacesRequest) (*racing.ListRacesResponse, error) {
-	races, err := s.racesRepo.List(in.Filter)
+	if err := validateListRacesRequest(in); err != nil {
+		return nil, toStatus(err)
+	}
+
+	races, nextPageToken, err := s.racesRepo.List(ctx, in)
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidPageToken) {
+			return nil, invalidField("page_token", describe(err, db.ErrInvalidPageToken))
+		}
+
+		return nil, toStatus(err)
+	}
+
+	if in.IncludeRunners {
+		if err := s.embedRunners(ctx, races); err != nil {
+			return nil, toStatus(err)
+		}
+	}
+
+	return &racing.ListRacesResponse{Races: races, NextPageToken: nextPageToken}, nil
+}
+
+func (s *racingService) GetRace(ctx context.Context, in *racing.GetRaceRequest) (*racing.Race, error) {
+	race, err := s.racesRepo.Get(ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, db.ErrRaceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "race %d not found", in.Id)
+		}
+
+		return nil, toStatus(err)
+	}
+
+	if in.IncludeRunners {
+		if err := s.embedRunners(ctx, []*racing.Race{race}); err != nil {
+			return nil, toStatus(err)
+		}
+	}
+
+	return race, nil
+}
+
+func (s *racingService) CreateRace(ctx context.Context, in *racing.CreateRaceRequest) (*racing.Race, error) {
+	if in.Race == nil {
+		return nil, invalidField("race", "is required")
+	}
+
+	if err := validateRaceFields(in.Race, db.RaceUpdateFields()); err != nil {
+		return nil, toStatus(err)
+	}
+
+	race, err := s.racesRepo.Create(ctx, in.Race)
+	if err != nil {
+		if errors.Is(err, db.ErrMeetingNotFound) {
+			return nil, invalidField("race.meeting_id", fmt.Sprintf("meeting %d not found", in.Race.MeetingId))
+		}
+
+		return nil, toStatus(err)
+	}
+
+	return race, nil
+}
+
+func (s *racingService) UpdateRace(ctx context.Context, in *racing.UpdateRaceRequest) (*racing.Race, error) {
+	if in.Race == nil {
+		return nil, invalidField("race", "is required")
+	}
+
+	fields := updateMaskFields(in.UpdateMask.GetPaths())
+
+	if err := validateRaceFields(in.Race, fields); err != nil {
+		return nil, toStatus(err)
+	}
+
+	race, err := s.racesRepo.Update(ctx, in.Race, fields, in.Actor)
+	if err != nil {
+		if errors.Is(err, db.ErrRaceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "race %d not found", in.Race.Id)
+		}
+
+		if errors.Is(err, db.ErrInvalidTransition) {
+			return nil, status.Error(codes.FailedPrecondition, describe(err, db.ErrInvalidTransition))
+		}
+
+		if errors.Is(err, db.ErrMeetingNotFound) {
+			return nil, invalidField("race.meeting_id", fmt.Sprintf("meeting %d not found", in.Race.MeetingId))
+		}
+
+		return nil, toStatus(err)
+	}
+
+	return race, nil
+}
+
+func (s *racingService) DeleteRace(ctx context.Context, in *racing.DeleteRaceRequest) (*empty.Empty, error) {
+	if err := s.racesRepo.Delete(ctx, in.Id); err != nil {
+		if errors.Is(err, db.ErrRaceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "race %d not found", in.Id)
+		}
+
+		return nil, toStatus(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *racingService) ListMeetings(ctx context.Context, in *racing.ListMeetingsRequest) (*racing.ListMeetingsResponse, error) {
+	if err := validateListMeetingsRequest(in); err != nil {
+		return nil, toStatus(err)
+	}
+
+	meetings, nextPageToken, err := s.meetingsRepo.List(ctx, in)
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidPageToken) {
+			return nil, invalidField("page_token", describe(err, db.ErrInvalidPageToken))
+		}
+
+		return nil, toStatus(err)
+	}
+
+	return &racing.ListMeetingsResponse{Meetings: meetings, NextPageToken: nextPageToken}, nil
+}
+
+func (s *racingService) GetMeeting(ctx context.Context, in *racing.GetMeetingRequest) (*racing.Meeting, error) {
+	meeting, err := s.meetingsRepo.Get(ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, db.ErrMeetingNotFound) {
+			return nil, status.Errorf(codes.NotFound, "meeting %d not found", in.Id)
+		}
+
+		return nil, toStatus(err)
+	}
+
+	return meeting, nil
+}
+
+func (s *racingService) ListRunners(ctx context.Context, in *racing.ListRunnersRequest) (*racing.ListRunnersResponse, error) {
+	if _, err := s.racesRepo.Get(ctx, in.RaceId); err != nil {
+		if errors.Is(err, db.ErrRaceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "race %d not found", in.RaceId)
+		}
+
+		return nil, toStatus(err)
+	}
+
+	runners, err := s.runnersRepo.List(ctx, []int64{in.RaceId})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &racing.ListRunnersResponse{Runners: runners[in.RaceId]}, nil
+}
+
+func (s *racingService) ResultRace(ctx context.Context, in *racing.ResultRaceRequest) (*racing.RaceResult, error) {
+	if err := validateResultRaceRequest(in); err != nil {
+		return nil, toStatus(err)
+	}
+
+	race, err := s.racesRepo.Get(ctx, in.RaceId)
+	if err != nil {
+		if errors.Is(err, db.ErrRaceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "race %d not found", in.RaceId)
+		}
+
+		return nil, toStatus(err)
+	}
+
+	if !db.CanTransition(race.Status, racing.Race_RESULTED) {
+		return nil, status.Errorf(codes.FailedPrecondition, "race %d is %s, only CLOSED, INTERIM or PROTESTED races can be resulted", in.RaceId, race.Status)
+	}
+
+	runners, err := s.runnersRepo.List(ctx, []int64{in.RaceId})
 	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	if err := validatePlacedRunners(in, runners[in.RaceId]); err != nil {
 		return nil, err
 	}
 
-	return &racing.ListRacesResponse{Races: races}, nil
+	result, err := s.racesRepo.Result(ctx, &racing.RaceResult{RaceId: in.RaceId, Placings: in.Placings}, in.Actor)
+	if err != nil {
+		if errors.Is(err, db.ErrRaceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "race %d not found", in.RaceId)
+		}
+
+		if errors.Is(err, db.ErrInvalidTransition) {
+			return nil, status.Error(codes.FailedPrecondition, describe(err, db.ErrInvalidTransition))
+		}
+
+		return nil, toStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *racingService) GetRaceResult(ctx context.Context, in *racing.GetRaceResultRequest) (*racing.RaceResult, error) {
+	result, err := s.racesRepo.GetResult(ctx, in.RaceId)
+	if err != nil {
+		if errors.Is(err, db.ErrResultNotFound) {
+			return nil, status.Errorf(codes.NotFound, "race %d has no result", in.RaceId)
+		}
+
+		return nil, toStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *racingService) ListRaceStatusHistory(ctx context.Context, in *racing.ListRaceStatusHistoryRequest) (*racing.ListRaceStatusHistoryResponse, error) {
+	if _, err := s.racesRepo.Get(ctx, in.RaceId); err != nil {
+		if errors.Is(err, db.ErrRaceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "race %d not found", in.RaceId)
+		}
+
+		return nil, toStatus(err)
+	}
+
+	changes, err := s.racesRepo.ListStatusHistory(ctx, in.RaceId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &racing.ListRaceStatusHistoryResponse{Changes: changes}, nil
+}
+
+// embedRunners sets the runners of each race, loading those of every race at
+// once.
+func (s *racingService) embedRunners(ctx context.Context, races []*racing.Race) error {
+	ids := make([]int64, 0, len(races))
+	for _, race := range races {
+		ids = append(ids, race.Id)
+	}
+
+	runners, err := s.runnersRepo.List(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, race := range races {
+		race.Runners = runners[race.Id]
+	}
+
+	return nil
+}
+
+func (s *racingService) WatchRaces(in *racing.WatchRacesRequest, stream racing.Racing_WatchRacesServer) error {
+	if err := validateWatchRacesRequest(in); err != nil {
+		return err
+	}
+
+	// Subscribe before taking the snapshot so that no change made while it is
+	// being sent is missed; at worst a change is reflected twice.
+	subscription := s.racesRepo.Subscribe(in.ResumeAfter)
+	defer subscription.Cancel()
+
+	meetings := &meetingCache{repo: s.meetingsRepo, meetings: make(map[int64]*racing.Meeting)}
+
+	if subscription.Resumed {
+		for _, change := range subscription.Replayed {
+			if err := sendChange(in.Filter, change, meetings, stream); err != nil {
+				return toStatus(err)
+			}
+		}
+	} else if err := s.sendSnapshot(stream.Context(), in.Filter, subscription.Sequence, stream); err != nil {
+		return toStatus(err)
+	}
+
+	// Replayed changes were published before subscribing, so the last sequence
+	// sent is that of the subscription either way.
+	if err := stream.Send(&racing.RaceEvent{
+		Type:     racing.RaceEvent_SYNCED,
+		Sequence: subscription.Sequence,
+	}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case change, ok := <-subscription.Changes:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "watcher fell too far behind, please reconnect")
+			}
+
+			if err := sendChange(in.Filter, change, meetings, stream); err != nil {
+				return toStatus(err)
+			}
+		}
+	}
+}
+
+// sendChange streams a change to a race if it is relevant to the filter.
+// Changes are relevant when the race matches the filter either before or after
+// the change, so watchers learn of races leaving their filter.
+func sendChange(filter *racing.ListRacesRequestFilter, change db.RaceChange, meetings *meetingCache, stream racing.Racing_WatchRacesServer) error {
+	matches, err := meetings.raceMatchesFilter(stream.Context(), change.Race, filter)
+	if err != nil {
+		return err
+	}
+
+	if !matches && change.Previous != nil {
+		matches, err = meetings.raceMatchesFilter(stream.Context(), change.Previous, filter)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !matches {
+		return nil
+	}
+
+	return stream.Send(&racing.RaceEvent{
+		Type:     change.Type,
+		Race:     change.Race,
+		Sequence: change.Sequence,
+	})
+}
+
+// sendSnapshot streams every race matching the filter as a SNAPSHOT event.
+func (s *racingService) sendSnapshot(ctx context.Context, filter *racing.ListRacesRequestFilter, sequence int64, stream racing.Racing_WatchRacesServer) error {
+	request := &racing.ListRacesRequest{Filter: filter}
+
+	for {
+		races, nextPageToken, err := s.racesRepo.List(ctx, request)
+		if err != nil {
+			return err
+		}
+
+		for _, race := range races {
+			if err := stream.Send(&racing.RaceEvent{
+				Type:     racing.RaceEvent_SNAPSHOT,
+				Race:     race,
+				Sequence: sequence,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if nextPageToken == "" {
+			return nil
+		}
+
+		request.PageToken = nextPageToken
+	}
+}
+
+// meetingCache holds the meetings of races looked up while watching races,
+// which are only needed for filters restricting race types or venues.
+type meetingCache struct {
+	repo     db.MeetingsRepo
+	meetings map[int64]*racing.Meeting
+}
+
+// raceMatchesFilter reports whether a race satisfies a filter, looking up its
+// meeting if need be.
+func (c *meetingCache) raceMatchesFilter(ctx context.Context, race *racing.Race, filter *racing.ListRacesRequestFilter) (bool, error) {
+	if !db.FilterNeedsMeeting(filter) {
+		return db.RaceMatchesFilter(race, nil, filter), nil
+	}
+
+	meeting, ok := c.meetings[race.MeetingId]
+	if !ok {
+		var err error
+
+		meeting, err = c.repo.Get(ctx, race.MeetingId)
+		if err != nil && !errors.Is(err, db.ErrMeetingNotFound) {
+			return false, err
+		}
+
+		c.meetings[race.MeetingId] = meeting
+	}
+
+	return db.RaceMatchesFilter(race, meeting, filter), nil
+}
+
+// updateMaskFields resolves the fields an update applies to. Following AIP-134,
+// an empty mask or the "*" wildcard replaces every updatable field.
+func updateMaskFields(paths []string) []string {
+	if len(paths) == 0 {
+		return db.RaceUpdateFields()
+	}
+
+	for _, path := range paths {
+		if path == "*" {
+			return db.RaceUpdateFields()
+		}
+	}
+
+	return paths
+}
+
+// toStatus converts context errors surfaced by the repository, such as a
+// cancelled request or an expired query deadline, into their gRPC status
+// equivalents. Other errors are returned unchanged.
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	}
+
+	return err
 }
@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"git.neds.sh/matty/entain/racing/db"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// testNow is the time the test races are seeded around.
+var testNow = time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// newTestRacingService returns a racing service over memory repositories
+// seeded with a few meetings and races.
+func newTestRacingService(t *testing.T) Racing {
+	service, _ := newTestRacingServiceWithRunners(t)
+
+	return service
+}
+
+// newTestRacingServiceWithRunners returns a racing service as does
+// newTestRacingService, and the repository its runners are entered into,
+// which is left empty.
+func newTestRacingServiceWithRunners(t *testing.T) (Racing, db.RunnersRepo) {
+	t.Helper()
+
+	ctx := context.Background()
+	meetings := db.NewMemoryMeetingsRepo()
+	races := db.NewMemoryRacesRepo(meetings, db.WithClock(func() time.Time { return testNow }))
+	runners := db.NewMemoryRunnersRepo(races)
+
+	opts := db.SeedOptions{Seed: 1, Meetings: 3, Races: 10, Runners: 4, From: testNow.AddDate(0, 0, -1), To: testNow.AddDate(0, 0, 1)}
+
+	if err := meetings.Seed(ctx, opts); err != nil {
+		t.Fatalf("seeding meetings: %s", err)
+	}
+
+	if err := races.Seed(ctx, opts); err != nil {
+		t.Fatalf("seeding races: %s", err)
+	}
+
+	return NewRacingService(races, meetings, runners), runners
+}
+
+// fieldViolations returns the field violations carried by an error's
+// BadRequest detail, as "field: description" strings.
+func fieldViolations(t *testing.T, err error) []string {
+	t.Helper()
+
+	var got []string
+
+	for _, detail := range status.Convert(err).Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+
+		for _, violation := range badRequest.FieldViolations {
+			got = append(got, violation.Field+": "+violation.Description)
+		}
+	}
+
+	return got
+}
+
+// nextPageToken lists the first page of races in the given order, returning
+// the token for the next.
+func nextPageToken(t *testing.T, service Racing, orderBy string) string {
+	t.Helper()
+
+	resp, err := service.ListRaces(context.Background(), &racing.ListRacesRequest{OrderBy: orderBy, PageSize: 1})
+	if err != nil {
+		t.Fatalf("listing races: %s", err)
+	}
+
+	if resp.NextPageToken == "" {
+		t.Fatal("listing races returned no next page token")
+	}
+
+	return resp.NextPageToken
+}
+
+func TestListRacesInvalidArguments(t *testing.T) {
+	service := newTestRacingService(t)
+
+	tooManyMeetingIDs := make([]int64, maxMeetingIDs+1)
+	for i := range tooManyMeetingIDs {
+		tooManyMeetingIDs[i] = int64(i + 1)
+	}
+
+	for _, test := range []struct {
+		name string
+		in   *racing.ListRacesRequest
+		want []string
+	}{
+		{
+			name: "negative meeting ID",
+			in:   &racing.ListRacesRequest{Filter: &racing.ListRacesRequestFilter{MeetingIds: []int64{1, -2}}},
+			want: []string{"filter.meeting_ids[1]: must be positive"},
+		},
+		{
+			name: "zero and negative meeting IDs",
+			in:   &racing.ListRacesRequest{Filter: &racing.ListRacesRequestFilter{MeetingIds: []int64{0, 3, -4}}},
+			want: []string{"filter.meeting_ids[0]: must be positive", "filter.meeting_ids[2]: must be positive"},
+		},
+		{
+			name: "too many meeting IDs",
+			in:   &racing.ListRacesRequest{Filter: &racing.ListRacesRequestFilter{MeetingIds: tooManyMeetingIDs}},
+			want: []string{fmt.Sprintf("filter.meeting_ids: must contain at most %d IDs", maxMeetingIDs)},
+		},
+		{
+			name: "unknown order_by field",
+			in:   &racing.ListRacesRequest{OrderBy: "colour"},
+			want: []string{`order_by: unknown field "colour"`},
+		},
+		{
+			name: "unknown order_by direction",
+			in:   &racing.ListRacesRequest{OrderBy: "name sideways"},
+			want: []string{`order_by: unknown direction "sideways" for field "name"`},
+		},
+		{
+			name: "duplicate order_by field",
+			in:   &racing.ListRacesRequest{OrderBy: "name, name desc"},
+			want: []string{`order_by: duplicate field "name"`},
+		},
+		{
+			name: "malformed page token",
+			in:   &racing.ListRacesRequest{PageToken: "not a token"},
+			want: []string{"page_token: malformed token"},
+		},
+		{
+			name: "page token for another order",
+			in:   &racing.ListRacesRequest{OrderBy: "number", PageToken: nextPageToken(t, service, "name")},
+			want: []string{"page_token: token does not match the request's filter or order_by"},
+		},
+		{
+			name: "several violations",
+			in: &racing.ListRacesRequest{
+				Filter:   &racing.ListRacesRequestFilter{MeetingIds: []int64{-1}},
+				OrderBy:  "colour",
+				PageSize: -1,
+			},
+			want: []string{
+				"filter.meeting_ids[0]: must be positive",
+				`order_by: unknown field "colour"`,
+				"page_size: must not be negative",
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := service.ListRaces(context.Background(), test.in)
+
+			if code := status.Code(err); code != codes.InvalidArgument {
+				t.Fatalf("got code %s (%v), want %s", code, err, codes.InvalidArgument)
+			}
+
+			got := fieldViolations(t, err)
+			if fmt.Sprint(got) != fmt.Sprint(test.want) {
+				t.Errorf("got violations %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+// TestListRacesInvalidArgumentOverHTTP checks the gateway maps a rejected
+// request to a 400 whose body carries the field violations.
+func TestListRacesInvalidArgumentOverHTTP(t *testing.T) {
+	service := newTestRacingService(t)
+
+	_, err := service.ListRaces(context.Background(), &racing.ListRacesRequest{Filter: &racing.ListRacesRequestFilter{MeetingIds: []int64{-1}}})
+	if err == nil {
+		t.Fatal("listing races with a negative meeting ID succeeded")
+	}
+
+	mux := runtime.NewServeMux()
+	marshaler, _ := runtime.MarshalerForRequest(mux, httptest.NewRequest(http.MethodGet, "/v1/races", nil))
+
+	recorder := httptest.NewRecorder()
+	runtime.HTTPError(context.Background(), mux, marshaler, recorder, httptest.NewRequest(http.MethodGet, "/v1/races", nil), err)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("got HTTP status %d, want %d", recorder.Code, http.StatusBadRequest)
+	}
+
+	var body struct {
+		Code    int32 `json:"code"`
+		Details []struct {
+			Type            string `json:"@type"`
+			FieldViolations []struct {
+				Field       string `json:"field"`
+				Description string `json:"description"`
+			} `json:"fieldViolations"`
+		} `json:"details"`
+	}
+
+	if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decoding body %q: %s", recorder.Body, err)
+	}
+
+	if codes.Code(body.Code) != codes.InvalidArgument {
+		t.Errorf("got body code %d, want %d", body.Code, codes.InvalidArgument)
+	}
+
+	if len(body.Details) != 1 || body.Details[0].Type != "type.googleapis.com/google.rpc.BadRequest" {
+		t.Fatalf("got details %+v, want a single BadRequest", body.Details)
+	}
+
+	violations := body.Details[0].FieldViolations
+	if len(violations) != 1 || violations[0].Field != "filter.meeting_ids[0]" || violations[0].Description != "must be positive" {
+		t.Errorf("got field violations %+v, want filter.meeting_ids[0] must be positive", violations)
+	}
+}
+
+// newTestResultService returns a racing service as does newTestRacingService,
+// the IDs of two of its CLOSED races, and of runners entered into them. The
+// first race has the first four runners, the last of them scratched, and the
+// second race the fifth.
+func newTestResultService(t *testing.T) (Racing, int64, []int64) {
+	t.Helper()
+
+	ctx := context.Background()
+	service, runners := newTestRacingServiceWithRunners(t)
+
+	closed, err := service.ListRaces(ctx, &racing.ListRacesRequest{Filter: &racing.ListRacesRequestFilter{Status: racing.Race_CLOSED}, PageSize: 2})
+	if err != nil {
+		t.Fatalf("listing races: %s", err)
+	}
+
+	if len(closed.Races) != 2 {
+		t.Fatalf("listed %d CLOSED races, want 2", len(closed.Races))
+	}
+
+	raceID, otherRaceID := closed.Races[0].Id, closed.Races[1].Id
+
+	var runnerIDs []int64
+
+	for _, runner := range []*racing.Runner{
+		{RaceId: raceID, Number: 1, Name: "Winx"},
+		{RaceId: raceID, Number: 2, Name: "Black Caviar"},
+		{RaceId: raceID, Number: 3, Name: "Makybe Diva"},
+		{RaceId: raceID, Number: 4, Name: "Phar Lap", Scratched: true},
+		{RaceId: otherRaceID, Number: 1, Name: "Kingston Town"},
+	} {
+		created, err := runners.Create(ctx, runner)
+		if err != nil {
+			t.Fatalf("creating runner: %s", err)
+		}
+
+		runnerIDs = append(runnerIDs, created.Id)
+	}
+
+	return service, raceID, runnerIDs
+}
+
+func TestResultRace(t *testing.T) {
+	ctx := context.Background()
+
+	// placings places the runners created by newTestResultService, by their
+	// index, at the given positions.
+	placings := func(runnerIDs []int64, indexes, positions []int64) []*racing.Placing {
+		var placings []*racing.Placing
+		for i, index := range indexes {
+			placings = append(placings, &racing.Placing{RunnerId: runnerIDs[index], Position: positions[i]})
+		}
+
+		return placings
+	}
+
+	for _, test := range []struct {
+		name      string
+		indexes   []int64
+		positions []int64
+		// want returns the violations wanted, given the race and runner IDs,
+		// or none should the result be accepted.
+		want func(raceID int64, runnerIDs []int64) []string
+	}{
+		{
+			name:      "dead heat for first",
+			indexes:   []int64{0, 1, 2},
+			positions: []int64{1, 1, 3},
+		},
+		{
+			name:      "dead heat for first then second",
+			indexes:   []int64{0, 1, 2},
+			positions: []int64{1, 1, 2},
+			want: func(raceID int64, runnerIDs []int64) []string {
+				return []string{"placings[2].position: must be 3, as 2 runners are placed ahead"}
+			},
+		},
+		{
+			name:      "scratched runner",
+			indexes:   []int64{0, 3},
+			positions: []int64{1, 2},
+			want: func(raceID int64, runnerIDs []int64) []string {
+				return []string{fmt.Sprintf("placings[1].runner_id: runner %d is scratched", runnerIDs[3])}
+			},
+		},
+		{
+			name:      "runner from another race",
+			indexes:   []int64{0, 4},
+			positions: []int64{1, 2},
+			want: func(raceID int64, runnerIDs []int64) []string {
+				return []string{fmt.Sprintf("placings[1].runner_id: runner %d is not in race %d", runnerIDs[4], raceID)}
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			service, raceID, runnerIDs := newTestResultService(t)
+
+			result, err := service.ResultRace(ctx, &racing.ResultRaceRequest{RaceId: raceID, Placings: placings(runnerIDs, test.indexes, test.positions)})
+
+			if test.want == nil {
+				if err != nil {
+					t.Fatalf("resulting race: %s", err)
+				}
+
+				if result.RaceId != raceID || len(result.Placings) != len(test.indexes) {
+					t.Errorf("got result %v, want race %d with %d placings", result, raceID, len(test.indexes))
+				}
+
+				return
+			}
+
+			if code := status.Code(err); code != codes.InvalidArgument {
+				t.Fatalf("got code %s (%v), want %s", code, err, codes.InvalidArgument)
+			}
+
+			want := test.want(raceID, runnerIDs)
+
+			got := fieldViolations(t, err)
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Errorf("got violations %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,300 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"git.neds.sh/matty/entain/racing/db"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxMeetingIDs caps the number of meeting IDs a filter may contain.
+	maxMeetingIDs = 100
+
+	// maxOdds caps the decimal odds a runner may be priced at.
+	maxOdds = 1001
+)
+
+// violations collects the fields of a request that failed validation.
+type violations []*errdetails.BadRequest_FieldViolation
+
+// add records a field that failed validation.
+func (v *violations) add(field, description string) {
+	*v = append(*v, &errdetails.BadRequest_FieldViolation{Field: field, Description: description})
+}
+
+// err returns an InvalidArgument error carrying a BadRequest detail listing
+// every violation, or nil when there are none.
+func (v violations) err() error {
+	if len(v) == 0 {
+		return nil
+	}
+
+	message := fmt.Sprintf("invalid %s: %s", v[0].Field, v[0].Description)
+	if len(v) > 1 {
+		message += fmt.Sprintf(" (and %d more)", len(v)-1)
+	}
+
+	st, err := status.New(codes.InvalidArgument, message).WithDetails(&errdetails.BadRequest{FieldViolations: v})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, message)
+	}
+
+	return st.Err()
+}
+
+// invalidField returns an InvalidArgument error for a single field.
+func invalidField(field, description string) error {
+	var v violations
+	v.add(field, description)
+
+	return v.err()
+}
+
+// describe returns the description of an error wrapping a sentinel, without
+// the sentinel's own message repeating the field name.
+func describe(err, sentinel error) string {
+	return strings.TrimPrefix(err.Error(), sentinel.Error()+": ")
+}
+
+// validateListRacesRequest checks a ListRacesRequest is well formed.
+func validateListRacesRequest(in *racing.ListRacesRequest) error {
+	var v violations
+
+	validateFilter("filter", in.Filter, &v)
+
+	if err := db.ValidateOrderBy(in.OrderBy); err != nil {
+		v.add("order_by", describe(err, db.ErrInvalidOrderBy))
+	}
+
+	if in.PageSize < 0 {
+		v.add("page_size", "must not be negative")
+	}
+
+	return v.err()
+}
+
+// validateWatchRacesRequest checks a WatchRacesRequest is well formed.
+func validateWatchRacesRequest(in *racing.WatchRacesRequest) error {
+	var v violations
+
+	validateFilter("filter", in.Filter, &v)
+
+	if in.ResumeAfter < 0 {
+		v.add("resume_after", "must not be negative")
+	}
+
+	return v.err()
+}
+
+// validateFilter records the violations of a races filter, with field names
+// prefixed by the filter's own.
+func validateFilter(prefix string, filter *racing.ListRacesRequestFilter, v *violations) {
+	if filter == nil {
+		return
+	}
+
+	if len(filter.MeetingIds) > maxMeetingIDs {
+		v.add(prefix+".meeting_ids", fmt.Sprintf("must contain at most %d IDs", maxMeetingIDs))
+	}
+
+	for i, meetingID := range filter.MeetingIds {
+		if meetingID <= 0 {
+			v.add(fmt.Sprintf("%s.meeting_ids[%d]", prefix, i), "must be positive")
+		}
+	}
+
+	if _, ok := racing.Visibility_name[int32(filter.Visibility)]; !ok {
+		v.add(prefix+".visibility", fmt.Sprintf("unknown visibility %d", filter.Visibility))
+	}
+
+	if _, ok := racing.Race_Status_name[int32(filter.Status)]; !ok {
+		v.add(prefix+".status", fmt.Sprintf("unknown status %d", filter.Status))
+	}
+
+	validateMeetingCriteria(prefix, filter.RaceTypes, filter.Venues, v)
+}
+
+// validateListMeetingsRequest checks a ListMeetingsRequest is well formed.
+func validateListMeetingsRequest(in *racing.ListMeetingsRequest) error {
+	var v violations
+
+	if in.Filter != nil {
+		validateMeetingCriteria("filter", in.Filter.RaceTypes, in.Filter.Venues, &v)
+
+		if in.Filter.Date != "" {
+			if _, err := time.Parse("2006-01-02", in.Filter.Date); err != nil {
+				v.add("filter.date", "must be formatted YYYY-MM-DD")
+			}
+		}
+	}
+
+	if in.PageSize < 0 {
+		v.add("page_size", "must not be negative")
+	}
+
+	return v.err()
+}
+
+// validateResultRaceRequest checks a ResultRaceRequest is well formed: each
+// runner is placed once, and positions follow competition ranking, so runners
+// that dead heat share a position and the positions they span are skipped.
+func validateResultRaceRequest(in *racing.ResultRaceRequest) error {
+	var v violations
+
+	if len(in.Placings) == 0 {
+		v.add("placings", "must place at least one runner")
+	}
+
+	placed := make(map[int64]bool)
+
+	for i, placing := range in.Placings {
+		if placing.RunnerId <= 0 {
+			v.add(fmt.Sprintf("placings[%d].runner_id", i), "must be positive")
+		} else if placed[placing.RunnerId] {
+			v.add(fmt.Sprintf("placings[%d].runner_id", i), fmt.Sprintf("runner %d is placed more than once", placing.RunnerId))
+		}
+
+		placed[placing.RunnerId] = true
+
+		if placing.Position <= 0 {
+			v.add(fmt.Sprintf("placings[%d].position", i), "must be positive")
+			continue
+		}
+
+		ahead := 0
+		for _, other := range in.Placings {
+			if other.Position < placing.Position {
+				ahead++
+			}
+		}
+
+		if placing.Position != int64(ahead+1) {
+			v.add(fmt.Sprintf("placings[%d].position", i), fmt.Sprintf("must be %d, as %d runners are placed ahead", ahead+1, ahead))
+		}
+	}
+
+	return v.err()
+}
+
+// validatePlacedRunners checks every runner placed is one of the race's
+// runners, and was not scratched.
+func validatePlacedRunners(in *racing.ResultRaceRequest, runners []*racing.Runner) error {
+	var v violations
+
+	byID := make(map[int64]*racing.Runner, len(runners))
+	for _, runner := range runners {
+		byID[runner.Id] = runner
+	}
+
+	for i, placing := range in.Placings {
+		runner, ok := byID[placing.RunnerId]
+
+		switch {
+		case !ok:
+			v.add(fmt.Sprintf("placings[%d].runner_id", i), fmt.Sprintf("runner %d is not in race %d", placing.RunnerId, in.RaceId))
+		case runner.Scratched:
+			v.add(fmt.Sprintf("placings[%d].runner_id", i), fmt.Sprintf("runner %d is scratched", placing.RunnerId))
+		}
+	}
+
+	return v.err()
+}
+
+// validateSetPriceRequest checks a SetPriceRequest is well formed.
+func validateSetPriceRequest(in *racing.SetPriceRequest) error {
+	var v violations
+
+	if in.Type == racing.Price_TYPE_UNSPECIFIED {
+		v.add("type", "must be specified")
+	} else if _, ok := racing.Price_Type_name[int32(in.Type)]; !ok {
+		v.add("type", fmt.Sprintf("unknown price type %d", in.Type))
+	}
+
+	// Written so that NaN fails too.
+	if !(in.Odds > 1 && in.Odds <= maxOdds) {
+		v.add("odds", fmt.Sprintf("must be greater than 1 and at most %d", maxOdds))
+	}
+
+	return v.err()
+}
+
+// validateMeetingCriteria records the violations of the race types and venues
+// a filter restricts meetings to, with field names prefixed by the filter's.
+func validateMeetingCriteria(prefix string, raceTypes []racing.Meeting_RaceType, venues []string, v *violations) {
+	for i, raceType := range raceTypes {
+		if raceType == racing.Meeting_RACE_TYPE_UNSPECIFIED {
+			v.add(fmt.Sprintf("%s.race_types[%d]", prefix, i), "must be specified")
+		} else if _, ok := racing.Meeting_RaceType_name[int32(raceType)]; !ok {
+			v.add(fmt.Sprintf("%s.race_types[%d]", prefix, i), fmt.Sprintf("unknown race type %d", raceType))
+		}
+	}
+
+	for i, venue := range venues {
+		if strings.TrimSpace(venue) == "" {
+			v.add(fmt.Sprintf("%s.venues[%d]", prefix, i), "must not be empty")
+		}
+	}
+}
+
+// validateRaceFields checks the values of the given fields of a race are
+// acceptable to be written, and that the fields can be written at all. The
+// status can be written when named, but only to statuses set by hand: races
+// close as they start, and are resulted with their placings.
+func validateRaceFields(race *racing.Race, fields []string) error {
+	var v violations
+
+	updatable := map[string]bool{"status": true}
+	for _, field := range db.RaceUpdateFields() {
+		updatable[field] = true
+	}
+
+	for i, field := range fields {
+		if !updatable[field] {
+			v.add(fmt.Sprintf("update_mask.paths[%d]", i), fmt.Sprintf("field %q cannot be updated", field))
+			continue
+		}
+
+		switch field {
+		case "meeting_id":
+			if race.MeetingId <= 0 {
+				v.add("race.meeting_id", "must be positive")
+			}
+		case "name":
+			if race.Name == "" {
+				v.add("race.name", "is required")
+			}
+		case "number":
+			if race.Number <= 0 {
+				v.add("race.number", "must be positive")
+			}
+		case "advertised_start_time":
+			if race.AdvertisedStartTime == nil {
+				v.add("race.advertised_start_time", "is required")
+			} else if _, err := ptypes.Timestamp(race.AdvertisedStartTime); err != nil {
+				v.add("race.advertised_start_time", err.Error())
+			}
+		case "status":
+			switch race.Status {
+			case racing.Race_STATUS_UNSPECIFIED:
+				v.add("race.status", "must be specified")
+			case racing.Race_CLOSED:
+				v.add("race.status", "races close when their advertised start time passes")
+			case racing.Race_RESULTED:
+				v.add("race.status", "races are resulted with ResultRace")
+			default:
+				if _, ok := racing.Race_Status_name[int32(race.Status)]; !ok {
+					v.add("race.status", fmt.Sprintf("unknown status %d", race.Status))
+				}
+			}
+		}
+	}
+
+	return v.err()
+}
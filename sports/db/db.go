@@ -0,0 +1,114 @@
+package db
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"syreclabs.com/go/faker"
+)
+
+// competitions maps each seeded sport to the competitions its events belong to.
+var competitions = map[string][]string{
+	"Basketball":   {"NBA", "NBL"},
+	"Soccer":       {"English Premier League", "A-League"},
+	"Rugby League": {"NRL", "State of Origin"},
+	"Tennis":       {"Australian Open", "Wimbledon"},
+}
+
+// seedSports lists the keys of competitions in a stable order for seeding.
+var seedSports = []string{"Basketball", "Soccer", "Rugby League", "Tennis"}
+
+func (r *eventsRepo) seed() error {
+	for _, table := range []string{
+		`CREATE TABLE IF NOT EXISTS events (id INTEGER PRIMARY KEY, name TEXT, sport TEXT, competition TEXT, visible INTEGER, advertised_start_time DATETIME)`,
+		`CREATE TABLE IF NOT EXISTS participants (event_id INTEGER, position INTEGER, name TEXT, PRIMARY KEY (event_id, position))`,
+		`CREATE TABLE IF NOT EXISTS prices (event_id INTEGER, position INTEGER, odds REAL, PRIMARY KEY (event_id, position))`,
+		`CREATE TABLE IF NOT EXISTS results (event_id INTEGER PRIMARY KEY, status TEXT, winner TEXT, resulted_at DATETIME)`,
+	} {
+		if _, err := r.db.Exec(table); err != nil {
+			return err
+		}
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	insertEvent, err := tx.Prepare(`INSERT OR IGNORE INTO events(id, name, sport, competition, visible, advertised_start_time) VALUES (?,?,?,?,?,?)`)
+	if err != nil {
+		return err
+	}
+	defer insertEvent.Close()
+
+	insertParticipant, err := tx.Prepare(`INSERT OR IGNORE INTO participants(event_id, position, name) VALUES (?,?,?)`)
+	if err != nil {
+		return err
+	}
+	defer insertParticipant.Close()
+
+	insertPrice, err := tx.Prepare(`INSERT OR IGNORE INTO prices(event_id, position, odds) VALUES (?,?,?)`)
+	if err != nil {
+		return err
+	}
+	defer insertPrice.Close()
+
+	for i := 1; i <= 100; i++ {
+		sport := faker.RandomChoice(seedSports)
+		competition := faker.RandomChoice(competitions[sport])
+		participants := seedParticipants(sport)
+
+		if _, err := insertEvent.Exec(
+			i,
+			fmt.Sprintf("%s vs %s", participants[0], participants[1]),
+			sport,
+			competition,
+			faker.Number().Between(0, 1),
+			faker.Time().Between(time.Now().AddDate(0, 0, -1), time.Now().AddDate(0, 0, 2)).Format(time.RFC3339),
+		); err != nil {
+			return err
+		}
+
+		for position, name := range participants {
+			if _, err := insertParticipant.Exec(i, position, name); err != nil {
+				return err
+			}
+		}
+
+		for position, odds := range seedOdds() {
+			if _, err := insertPrice.Exec(i, position, odds); err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// seedMargin is the house's margin built into the seeded odds.
+const seedMargin = 0.05
+
+// seedOdds returns dummy odds of each of a pair of participants winning, the
+// first given a chance of between a quarter and three quarters.
+func seedOdds() []float64 {
+	first := float64(faker.RandomInt(25, 75)) / 100
+
+	odds := make([]float64, 0, 2)
+
+	for _, chance := range []float64{first, 1 - first} {
+		odds = append(odds, math.Round(100/(chance*(1+seedMargin)))/100)
+	}
+
+	return odds
+}
+
+// seedParticipants returns a pair of dummy participants for an event in the sport.
+func seedParticipants(sport string) []string {
+	if sport == "Tennis" {
+		return []string{faker.Name().Name(), faker.Name().Name()}
+	}
+
+	return []string{faker.Team().Name(), faker.Team().Name()}
+}
@@ -0,0 +1,349 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	_ "github.com/mattn/go-sqlite3"
+
+	"git.neds.sh/matty/entain/sports/proto/sports"
+)
+
+// EventsRepo provides repository access to sports events.
+type EventsRepo interface {
+	// Init will initialise our events repository.
+	Init() error
+
+	// List will return a list of events matching the filter, soonest first.
+	List(filter *sports.ListEventsRequestFilter) ([]*sports.Event, error)
+
+	// Result will record the outcome of an event, returning the event
+	// resulted. The status is RESULTED, once the event has started, or
+	// ABANDONED, at any time before it is resulted. The winner is empty for a
+	// draw or an abandoned event.
+	Result(eventID int64, status sports.Event_Status, winner string) (*sports.Event, error)
+}
+
+var (
+	// ErrEventNotFound is returned when resulting an event that does not exist.
+	ErrEventNotFound = errors.New("event not found")
+
+	// ErrEventNotStarted is returned when resulting an event yet to start,
+	// other than to abandon it.
+	ErrEventNotStarted = errors.New("event has not started")
+
+	// ErrEventFinal is returned when resulting an event already resulted or
+	// abandoned.
+	ErrEventFinal = errors.New("event already resulted")
+
+	// ErrNotParticipant is returned when resulting an event with a winner
+	// that is not one of its participants.
+	ErrNotParticipant = errors.New("winner is not a participant in the event")
+)
+
+type eventsRepo struct {
+	db    *sql.DB
+	init  sync.Once
+	clock func() time.Time
+}
+
+// RepoOption configures optional behaviour of a repository.
+type RepoOption func(*eventsRepo)
+
+// WithClock overrides the clock used to derive event statuses, allowing tests
+// to freeze time.
+func WithClock(clock func() time.Time) RepoOption {
+	return func(r *eventsRepo) {
+		r.clock = clock
+	}
+}
+
+// NewEventsRepo creates a new events repository.
+func NewEventsRepo(db *sql.DB, opts ...RepoOption) EventsRepo {
+	r := &eventsRepo{db: db, clock: time.Now}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Init prepares the events repository dummy data.
+func (r *eventsRepo) Init() error {
+	var err error
+
+	r.init.Do(func() {
+		// For test/example purposes, we seed the DB with some dummy events.
+		err = r.seed()
+	})
+
+	return err
+}
+
+func (r *eventsRepo) List(filter *sports.ListEventsRequestFilter) ([]*sports.Event, error) {
+	var (
+		err   error
+		query string
+		args  []interface{}
+	)
+
+	query = getEventQueries()[eventsList]
+
+	query, args = r.applyFilter(query, filter)
+	query += " ORDER BY datetime(advertised_start_time), id"
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := r.scanEvents(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.attachParticipants(events); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// eventTransitions lists the statuses a result can move each status to.
+// OPEN and CLOSED are derived from the advertised start time, so an event
+// that is yet to start can only be abandoned; RESULTED and ABANDONED are
+// final.
+var eventTransitions = map[sports.Event_Status][]sports.Event_Status{
+	sports.Event_OPEN:   {sports.Event_ABANDONED},
+	sports.Event_CLOSED: {sports.Event_RESULTED, sports.Event_ABANDONED},
+}
+
+// canTransition reports whether an event can move from one status to another.
+func canTransition(from, to sports.Event_Status) bool {
+	for _, allowed := range eventTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Result records the outcome of an event. The result is only inserted should
+// the event have none, so an event is resulted once.
+func (r *eventsRepo) Result(eventID int64, status sports.Event_Status, winner string) (*sports.Event, error) {
+	event, err := r.get(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canTransition(event.Status, status) {
+		if event.Status == sports.Event_OPEN {
+			return nil, ErrEventNotStarted
+		}
+
+		return nil, ErrEventFinal
+	}
+
+	if winner != "" && !contains(event.Participants, winner) {
+		return nil, ErrNotParticipant
+	}
+
+	res, err := r.db.Exec(getEventQueries()[resultsCreate], eventID, status.String(), winner, r.clock().Format(time.RFC3339))
+	if err != nil {
+		return nil, err
+	}
+
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	// Resulted by another call since the event was read.
+	if inserted == 0 {
+		return nil, ErrEventFinal
+	}
+
+	return r.get(eventID)
+}
+
+// get returns the event with the given ID.
+func (r *eventsRepo) get(id int64) (*sports.Event, error) {
+	events, err := r.List(&sports.ListEventsRequestFilter{Ids: []int64{id}})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(events) == 0 {
+		return nil, ErrEventNotFound
+	}
+
+	return events[0], nil
+}
+
+// eventStatus derives the status of an event from its recorded result, if
+// any, or else from whether it has started.
+func eventStatus(result string, advertisedStart, now time.Time) sports.Event_Status {
+	if status, ok := sports.Event_Status_value[result]; ok {
+		return sports.Event_Status(status)
+	}
+
+	if now.Before(advertisedStart) {
+		return sports.Event_OPEN
+	}
+
+	return sports.Event_CLOSED
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *eventsRepo) applyFilter(query string, filter *sports.ListEventsRequestFilter) (string, []interface{}) {
+	var (
+		clauses []string
+		args    []interface{}
+	)
+
+	if filter == nil {
+		return query, args
+	}
+
+	if len(filter.Ids) > 0 {
+		clauses = append(clauses, "id IN ("+strings.Repeat("?,", len(filter.Ids)-1)+"?)")
+
+		for _, id := range filter.Ids {
+			args = append(args, id)
+		}
+	}
+
+	if len(filter.Sports) > 0 {
+		clauses = append(clauses, "sport IN ("+strings.Repeat("?,", len(filter.Sports)-1)+"?)")
+
+		for _, sport := range filter.Sports {
+			args = append(args, sport)
+		}
+	}
+
+	if len(filter.Competitions) > 0 {
+		clauses = append(clauses, "competition IN ("+strings.Repeat("?,", len(filter.Competitions)-1)+"?)")
+
+		for _, competition := range filter.Competitions {
+			args = append(args, competition)
+		}
+	}
+
+	switch filter.Visibility {
+	case sports.Visibility_VISIBILITY_VISIBLE:
+		clauses = append(clauses, "visible = ?")
+		args = append(args, true)
+	case sports.Visibility_VISIBILITY_HIDDEN:
+		clauses = append(clauses, "visible = ?")
+		args = append(args, false)
+	}
+
+	if len(clauses) != 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+
+	return query, args
+}
+
+func (r *eventsRepo) scanEvents(
+	rows *sql.Rows,
+) ([]*sports.Event, error) {
+	defer rows.Close()
+
+	var events []*sports.Event
+
+	now := r.clock()
+
+	for rows.Next() {
+		var event sports.Event
+		var advertisedStart time.Time
+		var status string
+
+		if err := rows.Scan(&event.Id, &event.Name, &event.Sport, &event.Competition, &event.Visible, &advertisedStart, &status, &event.Winner); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, nil
+			}
+
+			return nil, err
+		}
+
+		ts, err := ptypes.TimestampProto(advertisedStart)
+		if err != nil {
+			return nil, err
+		}
+
+		event.AdvertisedStartTime = ts
+		event.Status = eventStatus(status, advertisedStart, now)
+
+		events = append(events, &event)
+	}
+
+	// An error reading a row ends iteration early; report it rather than
+	// returning a partial result.
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// attachParticipants loads the participants of all given events, with their
+// odds, in a single query and attaches them in their listed order.
+func (r *eventsRepo) attachParticipants(events []*sports.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	var (
+		byID = make(map[int64]*sports.Event, len(events))
+		args = make([]interface{}, 0, len(events))
+	)
+
+	for _, event := range events {
+		byID[event.Id] = event
+		args = append(args, event.Id)
+	}
+
+	query := getEventQueries()[participantsList] +
+		" WHERE p.event_id IN (" + strings.Repeat("?,", len(events)-1) + "?) ORDER BY p.event_id, p.position"
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			eventID int64
+			name    string
+			odds    sql.NullFloat64
+		)
+
+		if err := rows.Scan(&eventID, &name, &odds); err != nil {
+			return err
+		}
+
+		if event, ok := byID[eventID]; ok {
+			event.Participants = append(event.Participants, name)
+			event.Odds = append(event.Odds, odds.Float64)
+		}
+	}
+
+	return rows.Err()
+}
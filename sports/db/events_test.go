@@ -0,0 +1,255 @@
+package db
+
+import (
+	"database/sql"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"git.neds.sh/matty/entain/sports/proto/sports"
+)
+
+// testNow is the time the test events start relative to.
+var testNow = time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// testEvent is an event the tests list, with its participants in order.
+type testEvent struct {
+	id           int64
+	sport        string
+	competition  string
+	visible      bool
+	start        time.Time
+	participants []string
+	odds         []float64
+}
+
+// testEvents are listed, soonest first, in the order 4, 2, 1, 3. Events 1
+// and 3 share a start time, so are ordered by ID.
+var testEvents = []testEvent{
+	{1, "Basketball", "NBA", true, testNow.Add(time.Hour), []string{"Lakers", "Celtics"}, []float64{1.5, 2.4}},
+	{2, "Soccer", "A-League", false, testNow, []string{"Sydney FC", "Melbourne Victory"}, []float64{2.1, 1.7}},
+	{3, "Tennis", "Wimbledon", true, testNow.Add(time.Hour), []string{"Ash Barty", "Naomi Osaka"}, []float64{1.25, 3.6}},
+	{4, "Basketball", "NBL", false, testNow.Add(-time.Hour), []string{"Sydney Kings", "Perth Wildcats"}, []float64{1.9, 1.9}},
+}
+
+// openTestRepo opens an initialised events repository in a temporary SQLite
+// database, holding only the test events in place of the seeded ones, with
+// its clock stopped at testNow.
+func openTestRepo(t *testing.T) EventsRepo {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "sports.db"))
+	if err != nil {
+		t.Fatalf("opening database: %s", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	repo := NewEventsRepo(db, WithClock(func() time.Time { return testNow }))
+	if err := repo.Init(); err != nil {
+		t.Fatalf("initialising repository: %s", err)
+	}
+
+	for _, statement := range []string{`DELETE FROM events`, `DELETE FROM participants`, `DELETE FROM prices`} {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatalf("clearing seeded events: %s", err)
+		}
+	}
+
+	for _, event := range testEvents {
+		if _, err := db.Exec(
+			`INSERT INTO events(id, name, sport, competition, visible, advertised_start_time) VALUES (?,?,?,?,?,?)`,
+			event.id, event.participants[0]+" vs "+event.participants[1], event.sport, event.competition, event.visible, event.start.Format(time.RFC3339),
+		); err != nil {
+			t.Fatalf("inserting event: %s", err)
+		}
+
+		// Participants are inserted last first, to check they are listed by
+		// position rather than as stored.
+		for position := len(event.participants) - 1; position >= 0; position-- {
+			if _, err := db.Exec(`INSERT INTO participants(event_id, position, name) VALUES (?,?,?)`, event.id, position, event.participants[position]); err != nil {
+				t.Fatalf("inserting participant: %s", err)
+			}
+
+			if _, err := db.Exec(`INSERT INTO prices(event_id, position, odds) VALUES (?,?,?)`, event.id, position, event.odds[position]); err != nil {
+				t.Fatalf("inserting price: %s", err)
+			}
+		}
+	}
+
+	return repo
+}
+
+func TestEventsRepoSeed(t *testing.T) {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "sports.db"))
+	if err != nil {
+		t.Fatalf("opening database: %s", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	if err := NewEventsRepo(db).Init(); err != nil {
+		t.Fatalf("initialising repository: %s", err)
+	}
+
+	var events, participants, prices int
+	if err := db.QueryRow(`SELECT (SELECT COUNT(*) FROM events), (SELECT COUNT(*) FROM participants), (SELECT COUNT(*) FROM prices WHERE odds > 1)`).Scan(&events, &participants, &prices); err != nil {
+		t.Fatalf("counting seeded rows: %s", err)
+	}
+
+	if events != 100 || participants != 200 || prices != 200 {
+		t.Errorf("seeded %d events, %d participants and %d prices, want 100, 200 and 200", events, participants, prices)
+	}
+}
+
+func TestEventsRepoList(t *testing.T) {
+	repo := openTestRepo(t)
+
+	for _, test := range []struct {
+		name   string
+		filter *sports.ListEventsRequestFilter
+		want   []int64
+	}{
+		{"none", nil, []int64{4, 2, 1, 3}},
+		{"ids", &sports.ListEventsRequestFilter{Ids: []int64{3, 4, 9}}, []int64{4, 3}},
+		{"sports", &sports.ListEventsRequestFilter{Sports: []string{"Basketball", "Tennis"}}, []int64{4, 1, 3}},
+		{"competitions", &sports.ListEventsRequestFilter{Competitions: []string{"NBA", "A-League"}}, []int64{2, 1}},
+		{"visible", &sports.ListEventsRequestFilter{Visibility: sports.Visibility_VISIBILITY_VISIBLE}, []int64{1, 3}},
+		{"hidden", &sports.ListEventsRequestFilter{Visibility: sports.Visibility_VISIBILITY_HIDDEN}, []int64{4, 2}},
+		{"unknown sport", &sports.ListEventsRequestFilter{Sports: []string{"Curling"}}, nil},
+		{"combined", &sports.ListEventsRequestFilter{
+			Sports:     []string{"Basketball"},
+			Visibility: sports.Visibility_VISIBILITY_HIDDEN,
+		}, []int64{4}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			events, err := repo.List(test.filter)
+			if err != nil {
+				t.Fatalf("listing events: %s", err)
+			}
+
+			var got []int64
+			for _, event := range events {
+				got = append(got, event.Id)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got events %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestEventsRepoListParticipants(t *testing.T) {
+	repo := openTestRepo(t)
+
+	events, err := repo.List(nil)
+	if err != nil {
+		t.Fatalf("listing events: %s", err)
+	}
+
+	if len(events) != len(testEvents) {
+		t.Fatalf("listed %d events, want %d", len(events), len(testEvents))
+	}
+
+	for _, event := range events {
+		want := testEvents[event.Id-1]
+
+		if !reflect.DeepEqual(event.Participants, want.participants) {
+			t.Errorf("event %d has participants %v, want %v", event.Id, event.Participants, want.participants)
+		}
+
+		if !reflect.DeepEqual(event.Odds, want.odds) {
+			t.Errorf("event %d has odds %v, want %v", event.Id, event.Odds, want.odds)
+		}
+
+		if event.Sport != want.sport || event.Competition != want.competition || event.Visible != want.visible {
+			t.Errorf("event %d is %v, want %v", event.Id, event, want)
+		}
+
+		if !event.AdvertisedStartTime.AsTime().Equal(want.start) {
+			t.Errorf("event %d starts at %s, want %s", event.Id, event.AdvertisedStartTime.AsTime(), want.start)
+		}
+	}
+}
+
+func TestEventsRepoListStatus(t *testing.T) {
+	repo := openTestRepo(t)
+
+	events, err := repo.List(nil)
+	if err != nil {
+		t.Fatalf("listing events: %s", err)
+	}
+
+	// Events starting after testNow are open, and the rest closed.
+	want := map[int64]sports.Event_Status{
+		1: sports.Event_OPEN,
+		2: sports.Event_CLOSED,
+		3: sports.Event_OPEN,
+		4: sports.Event_CLOSED,
+	}
+
+	for _, event := range events {
+		if event.Status != want[event.Id] {
+			t.Errorf("event %d is %s, want %s", event.Id, event.Status, want[event.Id])
+		}
+	}
+}
+
+func TestEventsRepoResult(t *testing.T) {
+	repo := openTestRepo(t)
+
+	for _, test := range []struct {
+		name    string
+		eventID int64
+		status  sports.Event_Status
+		winner  string
+		wantErr error
+	}{
+		{"not found", 9, sports.Event_RESULTED, "Lakers", ErrEventNotFound},
+		{"not started", 1, sports.Event_RESULTED, "Lakers", ErrEventNotStarted},
+		{"not a participant", 4, sports.Event_RESULTED, "Lakers", ErrNotParticipant},
+		{"winner", 4, sports.Event_RESULTED, "Perth Wildcats", nil},
+		{"already resulted", 4, sports.Event_ABANDONED, "", ErrEventFinal},
+		{"abandoned", 2, sports.Event_ABANDONED, "", nil},
+		{"already abandoned", 2, sports.Event_RESULTED, "Sydney FC", ErrEventFinal},
+		{"abandoned before starting", 3, sports.Event_ABANDONED, "", nil},
+		{"resulted once abandoned before starting", 3, sports.Event_RESULTED, "", ErrEventFinal},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			event, err := repo.Result(test.eventID, test.status, test.winner)
+			if err != test.wantErr {
+				t.Fatalf("got error %v, want %v", err, test.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if event.Id != test.eventID || event.Status != test.status || event.Winner != test.winner {
+				t.Errorf("got event %d %s won by %q, want %d %s won by %q", event.Id, event.Status, event.Winner, test.eventID, test.status, test.winner)
+			}
+		})
+	}
+
+	// The results are kept when the events are listed again.
+	events, err := repo.List(&sports.ListEventsRequestFilter{Ids: []int64{1, 2, 3, 4}})
+	if err != nil {
+		t.Fatalf("listing events: %s", err)
+	}
+
+	statuses := make(map[int64]sports.Event_Status)
+	for _, event := range events {
+		statuses[event.Id] = event.Status
+
+		if event.Id == 4 && event.Winner != "Perth Wildcats" {
+			t.Errorf("event 4 won by %q, want Perth Wildcats", event.Winner)
+		}
+	}
+
+	want := map[int64]sports.Event_Status{1: sports.Event_OPEN, 2: sports.Event_ABANDONED, 3: sports.Event_ABANDONED, 4: sports.Event_RESULTED}
+	for id, status := range want {
+		if statuses[id] != status {
+			t.Errorf("event %d is %s, want %s", id, statuses[id], status)
+		}
+	}
+}
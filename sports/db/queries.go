@@ -0,0 +1,40 @@
+package db
+
+const (
+	eventsList       = "list"
+	participantsList = "participants"
+	resultsCreate    = "create_result"
+)
+
+func getEventQueries() map[string]string {
+	return map[string]string{
+		// Events with their results, should they have any.
+		eventsList: `
+			SELECT 
+				id, 
+				name, 
+				sport, 
+				competition, 
+				visible, 
+				advertised_start_time, 
+				COALESCE(r.status, ''), 
+				COALESCE(r.winner, '') 
+			FROM events 
+			LEFT JOIN results r ON r.event_id = id
+		`,
+		// Participants with their odds, should they be priced.
+		participantsList: `
+			SELECT 
+				p.event_id, 
+				p.name, 
+				o.odds 
+			FROM participants p 
+			LEFT JOIN prices o ON o.event_id = p.event_id AND o.position = p.position
+		`,
+		// An event is only resulted once.
+		resultsCreate: `
+			INSERT OR IGNORE INTO results(event_id, status, winner, resulted_at) 
+			VALUES (?,?,?,?)
+		`,
+	}
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"git.neds.sh/matty/entain/sports/db"
+	"git.neds.sh/matty/entain/sports/proto/sports"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Sports interface {
+	// ListEvents will return a collection of sports events.
+	ListEvents(ctx context.Context, in *sports.ListEventsRequest) (*sports.ListEventsResponse, error)
+
+	// ResultEvent will record the outcome of an event: its result once it
+	// has started, or that it was abandoned.
+	ResultEvent(ctx context.Context, in *sports.ResultEventRequest) (*sports.Event, error)
+}
+
+// sportsService implements the Sports interface.
+type sportsService struct {
+	eventsRepo db.EventsRepo
+}
+
+// NewSportsService instantiates and returns a new sportsService.
+func NewSportsService(eventsRepo db.EventsRepo) Sports {
+	return &sportsService{eventsRepo}
+}
+
+func (s *sportsService) ListEvents(ctx context.Context, in *sports.ListEventsRequest) (*sports.ListEventsResponse, error) {
+	events, err := s.eventsRepo.List(in.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sports.ListEventsResponse{Events: events}, nil
+}
+
+func (s *sportsService) ResultEvent(ctx context.Context, in *sports.ResultEventRequest) (*sports.Event, error) {
+	if in.EventId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "event_id must be positive")
+	}
+
+	switch in.Status {
+	case sports.Event_RESULTED:
+		if in.Draw == (in.Winner != "") {
+			return nil, status.Error(codes.InvalidArgument, "a resulted event must have either a winner or a draw")
+		}
+	case sports.Event_ABANDONED:
+		if in.Draw || in.Winner != "" {
+			return nil, status.Error(codes.InvalidArgument, "an abandoned event has neither a winner nor a draw")
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "status must be RESULTED or ABANDONED")
+	}
+
+	event, err := s.eventsRepo.Result(in.EventId, in.Status, in.Winner)
+
+	switch err {
+	case nil:
+		return event, nil
+	case db.ErrEventNotFound:
+		return nil, status.Errorf(codes.NotFound, "event %d not found", in.EventId)
+	case db.ErrNotParticipant:
+		return nil, status.Errorf(codes.InvalidArgument, "winner %q is not a participant in event %d", in.Winner, in.EventId)
+	case db.ErrEventNotStarted:
+		return nil, status.Errorf(codes.FailedPrecondition, "event %d has not started", in.EventId)
+	case db.ErrEventFinal:
+		return nil, status.Errorf(codes.FailedPrecondition, "event %d is already resulted", in.EventId)
+	default:
+		return nil, err
+	}
+}